@@ -0,0 +1,417 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+)
+
+// errors in the input of an item, they are reported with 400
+type invalidInputError struct {
+	message string
+}
+
+func (this *invalidInputError) Error() string {
+	return this.message
+}
+
+// the error of an item of the AppendInput
+type itemError struct {
+	payload string
+	index   int
+	err     error
+}
+
+func (this *itemError) Error() string {
+	return fmt.Sprintf("%s[%d]: %s", this.payload, this.index, this.err.Error())
+}
+
+func statusCode(err error) int {
+	if e, ok := err.(*itemError); ok {
+		err = e.err
+	}
+	switch err {
+	case nil:
+		return http.StatusOK
+	case deletedError, keyNotFoundError:
+		return http.StatusNotFound
+	case versionConflictError:
+		return http.StatusConflict
+	}
+	if _, ok := err.(*invalidInputError); ok {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func itemStatus(err error) *ItemStatus {
+	status := &ItemStatus{Code: uint32(statusCode(err))}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	return status
+}
+
+// how to undo a modify or a delete, or an append when header is nil
+type undo struct {
+	store  *StoreItem
+	offset uint64
+	header *Header
+	pos    uint32
+	data   []byte
+}
+
+// the restored record gets a new version, the modified one might have
+// been read meanwhile, so a modify checking the version it had must
+// fail; the caller must hold the compaction read lock and the modify
+// lock
+func (this *undo) rollback() error {
+	if this.header == nil {
+		_, err := this.store.deleteAt(this.offset)
+		return err
+	}
+
+	this.store.RLock()
+	defer this.store.RUnlock()
+	current, err := readHeader(this.store.descriptor, this.offset)
+	if err != nil {
+		return err
+	}
+	if len(this.data) > 0 {
+		_, err := this.store.descriptor.WriteAt(this.data, int64(this.offset+this.header.size())+int64(this.pos))
+		if err != nil {
+			return err
+		}
+	}
+	restored := *this.header
+	restored.version = current.version + 1
+	writeHeader(this.store.descriptor, this.offset, &restored)
+	this.store.written()
+	return nil
+}
+
+// the offset of an append that failed, no record can start there
+const failedOffset = math.MaxUint64
+
+// applies the items in order and stops at the first that fails, with
+// WithStatus failed items do not stop the others and their error is in
+// the status of the item
+func (this *MultiStore) applyBatch(input *AppendInput) (*AppendOutput, map[*StoreItem]bool, error) {
+	out := &AppendOutput{}
+	touched := map[*StoreItem]bool{}
+
+	if input.AppendPayload != nil {
+		out.Offset = make([]uint64, len(input.AppendPayload))
+		if input.WithStatus {
+			out.AppendStatus = make([]*ItemStatus, len(input.AppendPayload))
+		}
+		for idx, item := range input.AppendPayload {
+			storage := this.find(item.Namespace)
+			touched[storage] = true
+			offset, err := storage.appendItem(item)
+			if err != nil && !input.WithStatus {
+				return nil, nil, err
+			}
+			if err != nil {
+				offset = failedOffset
+			}
+			out.Offset[idx] = offset
+			if input.WithStatus {
+				out.AppendStatus[idx] = itemStatus(err)
+			}
+		}
+	}
+
+	if input.ModifyPayload != nil {
+		if input.WithStatus {
+			out.ModifyStatus = make([]*ItemStatus, len(input.ModifyPayload))
+		}
+		for idx, item := range input.ModifyPayload {
+			storage := this.find(item.Namespace)
+			touched[storage] = true
+			err := storage.modifyItem(item)
+			if err != nil && !input.WithStatus {
+				return nil, nil, err
+			}
+			if err == nil {
+				out.ModifiedCount++
+			}
+			if input.WithStatus {
+				out.ModifyStatus[idx] = itemStatus(err)
+			}
+		}
+	}
+
+	if input.DeletePayload != nil {
+		if input.WithStatus {
+			out.DeleteStatus = make([]*ItemStatus, len(input.DeletePayload))
+		}
+		for idx, item := range input.DeletePayload {
+			storage := this.find(item.Namespace)
+			touched[storage] = true
+			err := storage.deleteRecord(item.Offset)
+			if err != nil && !input.WithStatus {
+				return nil, nil, err
+			}
+			if err == nil {
+				out.DeletedCount++
+			}
+			if input.WithStatus {
+				out.DeleteStatus[idx] = itemStatus(err)
+			}
+		}
+	}
+
+	return out, touched, nil
+}
+
+// a record appended by the transaction, header is what modify will
+// find when the transaction is validated
+type pendingAppend struct {
+	header *Header
+	offset uint64
+}
+
+type transaction struct {
+	stores map[string]*StoreItem
+	// the latest append of every key, per namespace
+	keys map[*StoreItem]map[string]*pendingAppend
+	// the headers as the earlier modifies of the transaction leave them
+	headers map[*StoreItem]map[uint64]*Header
+	undos   []*undo
+}
+
+func (this *transaction) store(multiStore *MultiStore, namespace string) *StoreItem {
+	if namespace == "" {
+		namespace = "default"
+	}
+	storage, ok := this.stores[namespace]
+	if !ok {
+		storage = multiStore.find(namespace)
+		this.stores[namespace] = storage
+	}
+	return storage
+}
+
+// the header the modify will find, appends of the transaction are
+// checked with the header they will be written with
+func (this *transaction) header(storage *StoreItem, item *Modify) (*Header, error) {
+	if item.Key != "" {
+		if pending, ok := this.keys[storage][item.Key]; ok {
+			return pending.header, nil
+		}
+	}
+
+	offset, err := this.offset(storage, item.Key, item.Offset)
+	if err != nil {
+		return nil, err
+	}
+	if header, ok := this.headers[storage][offset]; ok {
+		return header, nil
+	}
+
+	storage.RLock()
+	header, err := readHeader(storage.descriptor, offset)
+	storage.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if this.headers[storage] == nil {
+		this.headers[storage] = map[uint64]*Header{}
+	}
+	this.headers[storage][offset] = header
+	return header, nil
+}
+
+func (this *transaction) offset(storage *StoreItem, key string, offset uint64) (uint64, error) {
+	if key == "" {
+		return offset, nil
+	}
+	if pending, ok := this.keys[storage][key]; ok {
+		return pending.offset, nil
+	}
+	offset, ok := storage.keys.get(key)
+	if !ok {
+		return 0, keyNotFoundError
+	}
+	return offset, nil
+}
+
+// checks every item before writing anything, a modify is checked
+// against the record as the earlier modifies leave it
+func (this *transaction) validate(multiStore *MultiStore, input *AppendInput) error {
+	for idx, item := range input.AppendPayload {
+		storage := this.store(multiStore, item.Namespace)
+		err := validateFields(item.Fields)
+		if err == nil {
+			err = validateKey(item.Key)
+		}
+		if err != nil {
+			return &itemError{"appendPayload", idx, err}
+		}
+		if item.Key != "" {
+			allocSize := item.AllocSize
+			if len(item.Data) > int(allocSize) {
+				allocSize = uint32(len(item.Data))
+			}
+			if this.keys[storage] == nil {
+				this.keys[storage] = map[string]*pendingAppend{}
+			}
+			this.keys[storage][item.Key] = &pendingAppend{header: &Header{dataLen: uint32(len(item.Data)), allocSize: allocSize}}
+		}
+	}
+
+	for idx, item := range input.ModifyPayload {
+		storage := this.store(multiStore, item.Namespace)
+		header, err := this.header(storage, item)
+		if err != nil {
+			return &itemError{"modifyPayload", idx, err}
+		}
+		_, end, err := checkModify(header, item)
+		if err != nil {
+			return &itemError{"modifyPayload", idx, err}
+		}
+		if end > header.dataLen || item.ResetLength {
+			header.dataLen = end
+		}
+		header.version++
+	}
+
+	for idx, item := range input.DeletePayload {
+		storage := this.store(multiStore, item.Namespace)
+		storage.RLock()
+		header, err := readHeader(storage.descriptor, item.Offset)
+		storage.RUnlock()
+		if err == nil && header.legacy {
+			err = legacyRecordError
+		}
+		if err != nil {
+			return &itemError{"deletePayload", idx, err}
+		}
+	}
+	return nil
+}
+
+// applies the items in the same order as applyBatch, the keys of the
+// appends are published only after all items are applied
+func (this *transaction) apply(multiStore *MultiStore, input *AppendInput, out *AppendOutput) error {
+	if input.AppendPayload != nil {
+		out.Offset = make([]uint64, len(input.AppendPayload))
+		for idx, item := range input.AppendPayload {
+			storage := this.store(multiStore, item.Namespace)
+			unkeyed := *item
+			unkeyed.Key = ""
+			offset, err := storage.appendItem(&unkeyed)
+			if err != nil {
+				return &itemError{"appendPayload", idx, err}
+			}
+			this.undos = append(this.undos, &undo{store: storage, offset: offset})
+			if item.Key != "" {
+				this.keys[storage][item.Key].offset = offset
+			}
+			out.Offset[idx] = offset
+		}
+	}
+
+	for idx, item := range input.ModifyPayload {
+		storage := this.store(multiStore, item.Namespace)
+		offset, err := this.offset(storage, item.Key, item.Offset)
+		if err != nil {
+			return &itemError{"modifyPayload", idx, err}
+		}
+		u, err := storage.modifyAt(offset, item, true)
+		if err != nil {
+			return &itemError{"modifyPayload", idx, err}
+		}
+		this.undos = append(this.undos, u)
+		out.ModifiedCount++
+	}
+
+	for idx, item := range input.DeletePayload {
+		storage := this.store(multiStore, item.Namespace)
+		u, err := storage.deleteAt(item.Offset)
+		if err != nil {
+			return &itemError{"deletePayload", idx, err}
+		}
+		this.undos = append(this.undos, u)
+		out.DeletedCount++
+	}
+
+	for storage, keys := range this.keys {
+		for key, pending := range keys {
+			storage.keys.put(key, pending.offset)
+		}
+	}
+	return nil
+}
+
+// undoes the applied items in reverse order, the appended records are
+// flagged as deleted; readers might have seen the applied items before
+// they were undone
+func (this *transaction) rollback() {
+	for i := len(this.undos) - 1; i >= 0; i-- {
+		u := this.undos[i]
+		err := u.rollback()
+		if err != nil {
+			log.Printf("%s failed to roll back the transaction at %d, err: %s", u.store.root, u.offset, err.Error())
+		}
+	}
+}
+
+// applies all items or none of them, the namespaces are locked so
+// compaction, modify and delete wait for the transaction
+func (this *MultiStore) applyTransaction(input *AppendInput) (*AppendOutput, map[*StoreItem]bool, error) {
+	tx := &transaction{
+		stores:  map[string]*StoreItem{},
+		keys:    map[*StoreItem]map[string]*pendingAppend{},
+		headers: map[*StoreItem]map[uint64]*Header{},
+	}
+	for _, item := range input.AppendPayload {
+		tx.store(this, item.Namespace)
+	}
+	for _, item := range input.ModifyPayload {
+		tx.store(this, item.Namespace)
+	}
+	for _, item := range input.DeletePayload {
+		tx.store(this, item.Namespace)
+	}
+
+	// always locked in the same order, so transactions can not deadlock
+	stores := make([]*StoreItem, 0, len(tx.stores))
+	for _, storage := range tx.stores {
+		stores = append(stores, storage)
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return stores[i].root < stores[j].root
+	})
+	for _, storage := range stores {
+		storage.compaction.RLock()
+		storage.modifyLock.Lock()
+	}
+	defer func() {
+		for i := len(stores) - 1; i >= 0; i-- {
+			stores[i].modifyLock.Unlock()
+			stores[i].compaction.RUnlock()
+		}
+	}()
+
+	err := tx.validate(this, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := &AppendOutput{}
+	err = tx.apply(this, input, out)
+	if err != nil {
+		tx.rollback()
+		return nil, nil, err
+	}
+
+	touched := map[*StoreItem]bool{}
+	for _, storage := range stores {
+		touched[storage] = true
+	}
+	return out, touched, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestTransaction(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_transaction_test")
+	os.RemoveAll(root)
+	multiStore := &MultiStore{stores: make(map[string]*StoreItem), root: root}
+	storage := multiStore.find("a")
+	defer storage.close()
+
+	r1, _ := storage.appendItem(&Append{Data: []byte("abc"), AllocSize: 8, Key: "r1"})
+	r2, _ := storage.append(0, []byte("def"))
+	end := storage.offset
+
+	expect := func(offset uint64, value string, version uint32) {
+		data, header, err := storage.readRecord(offset)
+		if err != nil || string(data) != value || header.version != version {
+			t.Logf("expected %s version %d at %d, got %s %v %v", value, version, offset, data, header, err)
+			t.FailNow()
+		}
+	}
+
+	// the fifth modify does not fit, nothing is written
+	modifies := []*Modify{}
+	for i := 0; i < 4; i++ {
+		modifies = append(modifies, &Modify{Namespace: "a", Offset: r1, Pos: -1, Data: []byte("x")})
+	}
+	modifies = append(modifies, &Modify{Namespace: "a", Offset: r1, Pos: -1, Data: []byte("xx")})
+	_, _, err := multiStore.applyTransaction(&AppendInput{
+		AppendPayload: []*Append{{Namespace: "a", Data: []byte("new"), Key: "r1"}},
+		ModifyPayload: modifies,
+		DeletePayload: []*Delete{{Namespace: "a", Offset: r2}},
+	})
+	if e, ok := err.(*itemError); !ok || e.payload != "modifyPayload" || e.index != 4 || statusCode(err) != http.StatusBadRequest {
+		t.Logf("expected the fifth modify to fail, got %v", err)
+		t.FailNow()
+	}
+	if storage.offset != end {
+		t.Log("the append was written")
+		t.FailNow()
+	}
+	expect(r1, "abc", 0)
+	expect(r2, "def", 0)
+
+	// modifies see the earlier items of the transaction
+	out, _, err := multiStore.applyTransaction(&AppendInput{
+		AppendPayload: []*Append{{Namespace: "a", Data: []byte("new"), AllocSize: 8, Key: "r3"}},
+		ModifyPayload: []*Modify{
+			{Namespace: "a", Key: "r1", Pos: -1, Data: []byte("1"), CheckVersion: true, ExpectedVersion: 0},
+			{Namespace: "a", Key: "r1", Pos: -1, Data: []byte("2"), CheckVersion: true, ExpectedVersion: 1},
+			{Namespace: "a", Key: "r3", Pos: -1, Data: []byte("!"), CheckVersion: true, ExpectedVersion: 0},
+		},
+	})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	expect(r1, "abc12", 2)
+	expect(out.Offset[0], "new!", 1)
+
+	// a failure while applying rolls back the earlier items
+	tx := &transaction{
+		stores:  map[string]*StoreItem{},
+		keys:    map[*StoreItem]map[string]*pendingAppend{storage: {"r4": {}}},
+		headers: map[*StoreItem]map[uint64]*Header{},
+	}
+	storage.compaction.RLock()
+	storage.modifyLock.Lock()
+	err = tx.apply(multiStore, &AppendInput{
+		AppendPayload: []*Append{{Namespace: "a", Data: []byte("new"), Key: "r4"}},
+		ModifyPayload: []*Modify{{Namespace: "a", Offset: r1, Data: []byte("xyz")}},
+		DeletePayload: []*Delete{{Namespace: "a", Offset: r2}, {Namespace: "a", Offset: r2 + 1}},
+	}, &AppendOutput{})
+	if err == nil {
+		t.Log("expected the second delete to fail")
+		t.FailNow()
+	}
+	appended := tx.undos[0].offset
+	tx.rollback()
+	storage.modifyLock.Unlock()
+	storage.compaction.RUnlock()
+
+	// the rollback is a new version
+	expect(r1, "abc12", 4)
+	expect(r2, "def", 1)
+	if _, err := storage.read(appended); err != deletedError {
+		t.Logf("expected the append to be deleted, got %v", err)
+		t.FailNow()
+	}
+	if _, _, err := storage.readKey("r4"); err != keyNotFoundError {
+		t.Logf("expected the key to be missing, got %v", err)
+		t.FailNow()
+	}
+
+	// by default the batch stops at the first failing item
+	end = storage.offset
+	_, _, err = multiStore.applyBatch(&AppendInput{
+		ModifyPayload: []*Modify{{Namespace: "a", Offset: r1, Data: []byte("x"), CheckVersion: true, ExpectedVersion: 0}},
+		DeletePayload: []*Delete{{Namespace: "a", Offset: r2}},
+	})
+	if err != versionConflictError {
+		t.Logf("expected versionConflictError, got %v", err)
+		t.FailNow()
+	}
+	expect(r2, "def", 1)
+	_, _, err = multiStore.applyBatch(&AppendInput{
+		ModifyPayload: []*Modify{{Namespace: "a", Key: "missing", Data: []byte("x")}},
+	})
+	if statusCode(err) != http.StatusNotFound {
+		t.Logf("expected 404 for a missing key, got %v", err)
+		t.FailNow()
+	}
+
+	// with status every item is applied and has a status
+	out, _, _ = multiStore.applyBatch(&AppendInput{
+		WithStatus:    true,
+		AppendPayload: []*Append{{Namespace: "a", Data: []byte("x"), Key: string(make([]byte, maxKeyLen+1))}, {Namespace: "a", Data: []byte("y")}},
+		ModifyPayload: []*Modify{
+			{Namespace: "a", Offset: r1, Data: []byte("x"), CheckVersion: true, ExpectedVersion: 0},
+			{Namespace: "a", Key: "missing", Data: []byte("x")},
+			{Namespace: "a", Offset: r2, Data: []byte("x")},
+		},
+		DeletePayload: []*Delete{{Namespace: "a", Offset: appended}},
+	})
+	codes := []uint32{}
+	for _, statuses := range [][]*ItemStatus{out.AppendStatus, out.ModifyStatus, out.DeleteStatus} {
+		for _, status := range statuses {
+			codes = append(codes, status.Code)
+		}
+	}
+	expected := []uint32{400, 200, 409, 404, 200, 200}
+	for i := range expected {
+		if len(codes) != len(expected) || codes[i] != expected[i] {
+			t.Logf("expected status codes %v, got %v", expected, codes)
+			t.FailNow()
+		}
+	}
+	if out.Offset[0] != failedOffset || out.Offset[1] < end || out.ModifiedCount != 1 || out.DeletedCount != 1 {
+		t.Logf("unexpected output %v", out)
+		t.FailNow()
+	}
+	expect(r2, "xef", 2)
+	os.RemoveAll(root)
+}
@@ -13,7 +13,8 @@ func TestCompact(t *testing.T) {
 	path := path.Join(os.TempDir(), "rochefort_compact_test")
 	os.RemoveAll(path)
 
-	storage := NewStorage(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
 
 	data := []byte{1, 2, 3, 4}
 	compactedOffset := uint32(0)
@@ -57,6 +58,124 @@ func TestCompact(t *testing.T) {
 	os.RemoveAll(path)
 }
 
+func TestCompactIndexed(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_compact_indexed_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+
+	things := map[uint64][]byte{}
+	for i := 0; i < 100; i++ {
+		data := []byte(fmt.Sprintf("%d", i))
+		tags := []string{"all"}
+		if i%2 == 0 {
+			tags = append(tags, "even")
+		}
+		offset, _ := storage.append(uint32(1024+i), data, tags...)
+		things[offset] = data
+	}
+
+	relocationMap, err := storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	all := query(storage.GetPostingsList("all").newTermQuery())
+	if len(all) != 100 {
+		t.Logf("expected 100 postings, got %d", len(all))
+		t.FailNow()
+	}
+	even := query(storage.GetPostingsList("even").newTermQuery())
+	if len(even) != 50 {
+		t.Logf("expected 50 postings, got %d", len(even))
+		t.FailNow()
+	}
+
+	for old, data := range things {
+		stored, err := storage.read(relocationMap[old])
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		if !bytes.Equal(stored, data) {
+			t.Log("not equals")
+			t.FailNow()
+		}
+	}
+
+	for _, offset := range all {
+		_, err := storage.read(uint64(offset))
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+	}
+	os.RemoveAll(path)
+}
+
+func TestCompactOnline(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_compact_online_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+
+	expected := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		data := fmt.Sprintf("before_%d", i)
+		storage.append(uint32(1024), []byte(data), "all")
+		expected[data] = true
+	}
+
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 1000; i++ {
+			storage.append(uint32(128), []byte(fmt.Sprintf("during_%d", i)), "all")
+		}
+		done <- true
+	}()
+
+	_, err := storage.compactOnline()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	<-done
+	for i := 0; i < 1000; i++ {
+		expected[fmt.Sprintf("during_%d", i)] = true
+	}
+
+	found := 0
+	storage.scan(ScanOptions{}, func(offset uint64, header *Header, data []byte) bool {
+		if !expected[string(data)] {
+			t.Logf("unexpected data at %d: %s", offset, string(data))
+			t.FailNow()
+		}
+		found++
+		return true
+	})
+	if found != len(expected) {
+		t.Logf("expected %d records, found %d", len(expected), found)
+		t.FailNow()
+	}
+
+	all := query(storage.GetPostingsList("all").newTermQuery())
+	if len(all) != len(expected) {
+		t.Logf("expected %d postings, got %d", len(expected), len(all))
+		t.FailNow()
+	}
+	for _, offset := range all {
+		data, err := storage.read(uint64(offset))
+		if err != nil || !expected[string(data)] {
+			t.Logf("bad posting %d", offset)
+			t.FailNow()
+		}
+	}
+	os.RemoveAll(path)
+}
+
 type Thing struct {
 	data      []byte
 	allocSize uint32
@@ -152,7 +271,7 @@ func TestForever(t *testing.T) {
 	for k := 0; k < 10; k++ {
 		done := make(chan bool, 1)
 		n := 100
-		storage := NewStorage(path)
+		storage := NewStorage(path, Durability{})
 		corruptors := []*Corruptor{}
 		for i := 0; i < n; i++ {
 			c := NewCorruptor(i, storage)
@@ -176,5 +295,6 @@ func TestForever(t *testing.T) {
 		for _, c := range corruptors {
 			c.Test(relocationMap)
 		}
+		storage.close()
 	}
 }
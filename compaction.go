@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+)
+
+// compaction replaces append.raw and every index at once: the new files
+// are written next to the current ones as <file>.compacted and synced,
+// then their names are written to compaction.marker, and only then they
+// are renamed over the current files
+//
+// if we crash before the marker is written, the .compacted files are
+// removed when the namespace is opened and the old files are used, if
+// we crash after it, the renames are finished when it is opened
+
+const compactedSuffix = ".compacted"
+const compactionMarker = "compaction.marker"
+
+// the number of compactions, swapped together with the other files, so
+// offsets from before a compaction are recognized after a restart
+const generationFile = "compaction.generation"
+
+func generationPath(root string) string {
+	return path.Join(root, generationFile)
+}
+
+func loadGeneration(root string) uint64 {
+	data, err := ioutil.ReadFile(generationPath(root))
+	if err != nil || len(data) != 8 {
+		if !os.IsNotExist(err) {
+			log.Printf("%s ignoring invalid %s, err: %v", root, generationFile, err)
+		}
+		return 0
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
+func stageGeneration(root string, generation uint64) error {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, generation)
+	return stageFile(generationPath(root), data)
+}
+
+// writes the new version of the file in filePath + compactedSuffix
+func stageFile(filePath string, data []byte) error {
+	f, err := os.OpenFile(filePath+compactedSuffix, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteAt(data, 0)
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	if err != nil {
+		os.Remove(filePath + compactedSuffix)
+	}
+	return err
+}
+
+func removeStaged(files []string) {
+	for _, f := range files {
+		os.Remove(f + compactedSuffix)
+	}
+}
+
+// makes the renames and removals in the directory durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// once the marker is on disk the staged files replace the current ones
+func writeCompactionMarker(root string, files []string) error {
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = path.Base(f)
+	}
+
+	markerPath := path.Join(root, compactionMarker)
+	tmpPath := markerPath + ".tmp"
+	err := ioutil.WriteFile(tmpPath, []byte(strings.Join(names, "\n")), 0600)
+	if err == nil {
+		f, openErr := os.Open(tmpPath)
+		if openErr != nil {
+			err = openErr
+		} else {
+			err = f.Sync()
+			f.Close()
+		}
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, markerPath)
+	}
+	if err == nil {
+		err = syncDir(root)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+	}
+	return err
+}
+
+// renames the staged files over the current ones and removes the
+// marker, files that are not staged anymore were already renamed
+func finishCompaction(root string, names []string) error {
+	for _, name := range names {
+		filePath := path.Join(root, path.Base(name))
+		err := os.Rename(filePath+compactedSuffix, filePath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	err := syncDir(root)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path.Join(root, compactionMarker))
+	if err != nil {
+		return err
+	}
+	return syncDir(root)
+}
+
+// finishes a compaction that crashed after writing its marker, must be
+// called before any file of the namespace is opened
+func recoverCompaction(root string) error {
+	data, err := ioutil.ReadFile(path.Join(root, compactionMarker))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for _, name := range strings.Split(string(data), "\n") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	log.Printf("%s recovery: finishing the swap of %d compacted files", root, len(names))
+	return finishCompaction(root, names)
+}
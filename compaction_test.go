@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func readDir(t *testing.T, root string) map[string][]byte {
+	files, err := ioutil.ReadDir(root)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	out := map[string][]byte{}
+	for _, f := range files {
+		data, err := ioutil.ReadFile(path.Join(root, f.Name()))
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		out[f.Name()] = data
+	}
+	return out
+}
+
+func writeDir(t *testing.T, root string, files map[string][]byte) {
+	for name, data := range files {
+		err := ioutil.WriteFile(path.Join(root, name), data, 0600)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+	}
+}
+
+func expectDir(t *testing.T, root string, expected map[string][]byte) {
+	actual := readDir(t, root)
+	if len(actual) != len(expected) {
+		t.Logf("expected files %v, got %v", keysOf(expected), keysOf(actual))
+		t.FailNow()
+	}
+	for name, data := range expected {
+		if !bytes.Equal(actual[name], data) {
+			t.Logf("%s is different", name)
+			t.FailNow()
+		}
+	}
+}
+
+func keysOf(files map[string][]byte) []string {
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// a crash before the marker is written keeps the old files, a crash
+// after it finishes the swap when the namespace is opened
+func TestCompactionCrash(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_compaction_crash_test")
+	os.RemoveAll(root)
+
+	storage := NewStorage(root, Durability{})
+	offsets := []uint64{}
+	for i := 0; i < 300; i++ {
+		offset, err := storage.appendItem(&Append{
+			Data:   []byte(fmt.Sprintf("value %d", i)),
+			Tags:   []string{"a"},
+			Key:    fmt.Sprintf("k%d", i%10),
+			Fields: map[string]float64{"n": float64(i)},
+		})
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		offsets = append(offsets, offset)
+	}
+	for i := 0; i < 300; i += 3 {
+		storage.deleteRecord(offsets[i])
+	}
+	storage.commitGroup("g", offsets[150], 0)
+
+	before := readDir(t, root)
+	_, err := storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	after := readDir(t, root)
+	storage.close()
+	for name := range after {
+		if strings.HasSuffix(name, compactedSuffix) || name == compactionMarker {
+			t.Logf("%s was left after the compaction", name)
+			t.FailNow()
+		}
+	}
+
+	reset := func() {
+		os.RemoveAll(root)
+		os.MkdirAll(root, 0700)
+		writeDir(t, root, before)
+	}
+
+	// opening merges the tails, so the old files are compared after
+	// they were opened once
+	reset()
+	NewStorage(root, Durability{}).close()
+	before = readDir(t, root)
+
+	// crashed after staging the files
+	reset()
+	staged := map[string][]byte{}
+	for name, data := range after {
+		staged[name+compactedSuffix] = data
+	}
+	writeDir(t, root, staged)
+	NewStorage(root, Durability{}).close()
+	expectDir(t, root, before)
+
+	// crashed in the middle of the renames
+	reset()
+	names := keysOf(after)
+	for i, name := range names {
+		if i%2 == 0 {
+			writeDir(t, root, map[string][]byte{name: after[name]})
+		} else {
+			writeDir(t, root, map[string][]byte{name + compactedSuffix: after[name]})
+		}
+	}
+	err = writeCompactionMarker(root, names)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	storage = NewStorage(root, Durability{})
+	defer storage.close()
+	expectDir(t, root, after)
+
+	count := 0
+	storage.ExecuteQuery(storage.GetPostingsList("a").newTermQuery(), QueryOptions{}, func(offset uint64, header *Header, data []byte) bool {
+		count++
+		return true
+	})
+	if count != 200 {
+		t.Logf("expected 200 records, got %d", count)
+		t.FailNow()
+	}
+	data, _, err := storage.readKey("k9")
+	if err != nil || string(data) != "value 299" {
+		t.Logf("expected value 299, got %s %v", data, err)
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// never fsync, the os flushes whenever it wants
+	durabilityNone = "none"
+	// fsync every SyncInterval milliseconds
+	durabilityPeriodic = "periodic"
+	// fsync before /set returns, concurrent requests share the fsync
+	durabilityCommit = "commit"
+)
+
+type Durability struct {
+	Mode         string `json:"mode"`
+	SyncInterval uint32 `json:"syncInterval"`
+}
+
+func (this Durability) validate() error {
+	switch this.Mode {
+	case "", durabilityNone, durabilityCommit:
+		return nil
+	case durabilityPeriodic:
+		if this.SyncInterval == 0 {
+			return errors.New("periodic durability needs syncInterval > 0")
+		}
+		return nil
+	}
+	return errors.New("unknown durability mode, expected none, periodic or commit")
+}
+
+const durabilityFile = "durability.json"
+
+func loadDurability(root string) (Durability, bool) {
+	d := Durability{}
+	data, err := ioutil.ReadFile(path.Join(root, durabilityFile))
+	if err != nil {
+		return d, false
+	}
+	err = json.Unmarshal(data, &d)
+	if err != nil {
+		log.Printf("%s ignoring invalid %s, err: %s", root, durabilityFile, err.Error())
+		return d, false
+	}
+	return d, true
+}
+
+func saveDurability(root string, d Durability) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	tmpPath := path.Join(root, durabilityFile+".tmp")
+	err = ioutil.WriteFile(tmpPath, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path.Join(root, durabilityFile))
+}
+
+// persists the durability mode of the namespace and (re)starts the
+// periodic syncer if needed
+func (this *StoreItem) setDurability(d Durability, persist bool) error {
+	err := d.validate()
+	if err != nil {
+		return err
+	}
+	if d.Mode == "" {
+		d.Mode = durabilityNone
+	}
+	if persist {
+		err = saveDurability(this.root, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	this.Lock()
+	defer this.Unlock()
+	if this.stopSyncer != nil {
+		close(this.stopSyncer)
+		this.stopSyncer = nil
+	}
+	this.durability = d
+	if d.Mode == durabilityPeriodic {
+		this.stopSyncer = make(chan bool)
+		go this.syncPeriodically(time.Duration(d.SyncInterval)*time.Millisecond, this.stopSyncer)
+	}
+	log.Printf("%s durability: %s", this.root, d.Mode)
+	return nil
+}
+
+func (this *StoreItem) stopSyncing() {
+	this.Lock()
+	defer this.Unlock()
+	if this.stopSyncer != nil {
+		close(this.stopSyncer)
+		this.stopSyncer = nil
+	}
+}
+
+func (this *StoreItem) syncPeriodically(interval time.Duration, stop chan bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			err := this.commit()
+			if err != nil {
+				log.Printf("%s periodic sync failed, err: %s", this.root, err.Error())
+			}
+		}
+	}
+}
+
+// called after every append or modify, so commit() knows if it has
+// to fsync or someone else already did
+func (this *StoreItem) written() {
+	atomic.AddUint64(&this.writes, 1)
+}
+
+func (this *StoreItem) commitsOnWrite() bool {
+	this.RLock()
+	defer this.RUnlock()
+	return this.durability.Mode == durabilityCommit
+}
+
+// group commit: if another fsync started after our write, it
+// covers our write as well and we only wait for it
+func (this *StoreItem) commit() error {
+	writes := atomic.LoadUint64(&this.writes)
+
+	this.syncLock.Lock()
+	defer this.syncLock.Unlock()
+	if this.synced >= writes {
+		return nil
+	}
+
+	writes = atomic.LoadUint64(&this.writes)
+	err := this.sync()
+	if err != nil {
+		return err
+	}
+	this.synced = writes
+	return nil
+}
+
+func (this *StoreItem) sync() error {
+	this.RLock()
+	defer this.RUnlock()
+
+	err := this.descriptor.Sync()
+	if err != nil {
+		return err
+	}
+	for _, p := range this.index {
+		if atomic.SwapUint32(&p.dirty, 0) == 1 {
+			err = p.sync()
+			if err != nil {
+				atomic.StoreUint32(&p.dirty, 1)
+				return err
+			}
+		}
+	}
+	if atomic.SwapUint32(&this.keys.dirty, 0) == 1 {
+		err = this.keys.sync()
+		if err != nil {
+			atomic.StoreUint32(&this.keys.dirty, 1)
+			return err
+		}
+	}
+	for _, n := range this.fields {
+		if atomic.SwapUint32(&n.dirty, 0) == 1 {
+			err = n.sync()
+			if err != nil {
+				atomic.StoreUint32(&n.dirty, 1)
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"os"
+	"path"
+	"sync"
+	"testing"
+)
+
+func TestDurability(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_durability_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{Mode: durabilityPeriodic, SyncInterval: 10})
+	defer storage.close()
+	if storage.commitsOnWrite() {
+		t.Log("periodic storage should not commit on write")
+		t.FailNow()
+	}
+
+	err := storage.setDurability(Durability{Mode: durabilityCommit}, true)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				storage.append(16, []byte{1, 2, 3}, "a")
+				err := storage.commit()
+				if err != nil {
+					panic(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if storage.synced != storage.writes {
+		t.Logf("synced %d != writes %d", storage.synced, storage.writes)
+		t.FailNow()
+	}
+	storage.stopSyncing()
+
+	reopened := NewStorage(path, Durability{})
+	defer reopened.close()
+	if !reopened.commitsOnWrite() {
+		t.Log("durability was not persisted")
+		t.FailNow()
+	}
+
+	err = reopened.setDurability(Durability{Mode: durabilityPeriodic}, true)
+	if err == nil {
+		t.Log("expected error for periodic durability without interval")
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestCreateDurability(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_create_durability_test")
+	os.RemoveAll(root)
+	multiStore := &MultiStore{stores: make(map[string]*StoreItem), root: root, durability: Durability{Mode: durabilityCommit, SyncInterval: 50}}
+	defer multiStore.close("a")
+	defer multiStore.close("b")
+
+	// nothing is stored, the server default is used
+	err := multiStore.create("a", Durability{})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if _, ok := loadDurability(path.Join(root, "a")); ok || !multiStore.find("a").commitsOnWrite() {
+		t.Log("expected the server durability without durability.json")
+		t.FailNow()
+	}
+
+	// the server syncInterval is used
+	err = multiStore.create("b", Durability{Mode: durabilityPeriodic})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if d, ok := loadDurability(path.Join(root, "b")); !ok || d.Mode != durabilityPeriodic || d.SyncInterval != 50 {
+		t.Logf("expected periodic every 50ms, got %v", d)
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"time"
+)
+
+// counts the calls to the wrapped query
+type profiledQuery struct {
+	Query
+	node *ExplainNode
+}
+
+func (q *profiledQuery) produced(docId int64) int64 {
+	if docId != NO_MORE {
+		q.node.Docs++
+	}
+	return docId
+}
+
+func (q *profiledQuery) Next() int64 {
+	q.node.Next++
+	return q.produced(q.Query.Next())
+}
+
+func (q *profiledQuery) advance(target int64) int64 {
+	q.node.Advance++
+	return q.produced(q.Query.advance(target))
+}
+
+// wraps every node of the query tree, so the calls the parent makes to
+// its children are counted
+func profile(query Query) (Query, *ExplainNode) {
+	node := &ExplainNode{Cost: query.cost()}
+
+	wrap := func(sub Query) Query {
+		profiled, child := profile(sub)
+		node.Children = append(node.Children, child)
+		return profiled
+	}
+
+	switch q := query.(type) {
+	case *Term:
+		node.Type = "term"
+		node.Tag = q.tag
+	case *rangeQuery:
+		node.Type = "range"
+		node.Tag = q.field
+	case *BoolAndQuery:
+		node.Type = "and"
+		for i, sub := range q.queries {
+			q.queries[i] = wrap(sub)
+		}
+	case *BoolOrQuery:
+		node.Type = "or"
+		for i, sub := range q.queries {
+			q.queries[i] = wrap(sub)
+		}
+	case *BoolNotQuery:
+		node.Type = "not"
+		q.positive = wrap(q.positive)
+		q.negated = wrap(q.negated)
+	}
+
+	return &profiledQuery{Query: query, node: node}, node
+}
+
+// runs the query without sending the records, and returns the query
+// tree with the number of calls each node made
+func (this *StoreItem) explainQuery(query Query, options QueryOptions) (*ExplainOutput, error) {
+	started := time.Now()
+	profiled, node := profile(query)
+
+	out := &ExplainOutput{Query: node}
+	options.explain = out
+	err := this.ExecuteQuery(profiled, options, func(offset uint64, header *Header, data []byte) bool {
+		out.Matches++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.TookNanoseconds = time.Since(started).Nanoseconds()
+	return out, nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"sync/atomic"
+)
+
+// consumer groups keep their committed offset in <group>.group next
+// to append.raw, the offset is where the next fetch starts
+
+var emptyGroupNameError = errors.New("group name can not be empty")
+var compactedSinceFetchError = errors.New("namespace was compacted after the fetch, offsets have changed, fetch again")
+
+func (this *StoreItem) groupPath(name string) string {
+	return path.Join(this.root, fmt.Sprintf("%s.group", name))
+}
+
+func (this *StoreItem) loadGroup(name string) {
+	data, err := ioutil.ReadFile(this.groupPath(name))
+	if err != nil || len(data) != 8 {
+		log.Printf("%s ignoring invalid group %s, err: %v", this.root, name, err)
+		return
+	}
+	this.groups[name] = binary.LittleEndian.Uint64(data)
+}
+
+// must be called with groupsLock held
+func (this *StoreItem) storeGroup(name string, offset uint64) error {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, offset)
+
+	groupPath := this.groupPath(name)
+	tmpPath := groupPath + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	if err == nil {
+		err = os.Rename(tmpPath, groupPath)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	this.groups[name] = offset
+	return nil
+}
+
+func (this *StoreItem) committedOffset(name string) (uint64, error) {
+	name = sanitize(name)
+	if name == "" {
+		return 0, emptyGroupNameError
+	}
+	this.groupsLock.Lock()
+	defer this.groupsLock.Unlock()
+	return this.groups[name], nil
+}
+
+// the generation is the one returned by the fetch, compaction moves the
+// committed offsets but not the ones the consumers already fetched, so
+// those are rejected
+func (this *StoreItem) commitGroup(name string, offset uint64, generation uint64) error {
+	name = sanitize(name)
+	if name == "" {
+		return emptyGroupNameError
+	}
+
+	// compaction changes the generation with the groups lock held
+	this.groupsLock.Lock()
+	defer this.groupsLock.Unlock()
+	if generation != atomic.LoadUint64(&this.generation) {
+		return compactedSinceFetchError
+	}
+	if offset > atomic.LoadUint64(&this.offset) {
+		return fmt.Errorf("offset %d is after the end of the namespace %d", offset, atomic.LoadUint64(&this.offset))
+	}
+	return this.storeGroup(name, offset)
+}
+
+// returns the records after the committed offset of the group, the
+// offset is not moved until the consumer commits NextOffset
+func (this *StoreItem) fetchGroup(name string, limit int, skipCorrupt bool) (*FetchOutput, error) {
+	for {
+		generation := atomic.LoadUint64(&this.generation)
+		committed, err := this.committedOffset(name)
+		if err != nil {
+			return nil, err
+		}
+
+		out := &FetchOutput{Generation: generation}
+		out.NextOffset, err = this.scan(ScanOptions{from: committed, limit: limit, skipCorrupt: skipCorrupt}, func(offset uint64, header *Header, data []byte) bool {
+			out.Offset = append(out.Offset, offset)
+			out.Data = append(out.Data, data)
+			return true
+		})
+		// compacted while fetching, the offsets might be from both files
+		if atomic.LoadUint64(&this.generation) != generation {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+}
+
+func (this *StoreItem) listGroups() *GroupsOutput {
+	end := atomic.LoadUint64(&this.offset)
+
+	this.groupsLock.Lock()
+	defer this.groupsLock.Unlock()
+
+	out := &GroupsOutput{}
+	for name, offset := range this.groups {
+		lag := uint64(0)
+		if end > offset {
+			lag = end - offset
+		}
+		out.Groups = append(out.Groups, &Group{
+			Name:   name,
+			Offset: offset,
+			Lag:    lag,
+		})
+	}
+	sort.Slice(out.Groups, func(i, j int) bool {
+		return out.Groups[i].Name < out.Groups[j].Name
+	})
+	return out
+}
+
+// writes the committed offsets moved to the first record that was at
+// or after them before the compaction next to the group files, they are
+// renamed over them by swapCompacted(); must be called with groupsLock
+// held
+func (this *StoreItem) stageGroups(relocationMap map[uint64]uint64, end uint64) (map[string]uint64, []string, error) {
+	relocatedGroups := map[string]uint64{}
+	staged := []string{}
+	if len(this.groups) == 0 {
+		return relocatedGroups, staged, nil
+	}
+
+	old := make([]uint64, 0, len(relocationMap))
+	for offset := range relocationMap {
+		old = append(old, offset)
+	}
+	sort.Slice(old, func(i, j int) bool {
+		return old[i] < old[j]
+	})
+
+	for name, committed := range this.groups {
+		relocated := end
+		i := sort.Search(len(old), func(i int) bool {
+			return old[i] >= committed
+		})
+		if i < len(old) {
+			relocated = relocationMap[old[i]]
+		}
+
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, relocated)
+		staged = append(staged, this.groupPath(name))
+		err := stageFile(this.groupPath(name), data)
+		if err != nil {
+			return nil, staged, err
+		}
+		relocatedGroups[name] = relocated
+	}
+	return relocatedGroups, staged, nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestGroups(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_groups_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 100; i++ {
+		storage.append(64, []byte(fmt.Sprintf("%d", i)))
+	}
+
+	seen := 0
+	crashed := false
+	for {
+		out, err := storage.fetchGroup("workers", 30, false)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		if len(out.Data) == 0 {
+			break
+		}
+		for _, data := range out.Data {
+			if string(data) != fmt.Sprintf("%d", seen) {
+				t.Logf("expected %d got %s", seen, string(data))
+				t.FailNow()
+			}
+			seen++
+		}
+		if seen == 60 && !crashed {
+			crashed = true
+			// crash before committing, the batch is fetched again
+			seen -= len(out.Data)
+			storage.descriptor.Close()
+			storage = NewStorage(path, Durability{})
+			defer storage.close()
+			continue
+		}
+		err = storage.commitGroup("workers", out.NextOffset, out.Generation)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+	}
+	if seen != 100 {
+		t.Logf("expected 100 records, got %d", seen)
+		t.FailNow()
+	}
+
+	storage.commitGroup("slow", 0, 0)
+	groups := storage.listGroups().Groups
+	if len(groups) != 2 || groups[0].Name != "slow" || groups[0].Lag != storage.offset || groups[1].Lag != 0 {
+		t.Logf("unexpected groups %v", groups)
+		t.FailNow()
+	}
+
+	err := storage.commitGroup("slow", storage.offset+1, 0)
+	if err == nil {
+		t.Log("expected error when committing after the end")
+		t.FailNow()
+	}
+
+	// the committed offsets follow the compaction
+	out, _ := storage.fetchGroup("slow", 50, false)
+	storage.commitGroup("slow", out.NextOffset, out.Generation)
+	stale, _ := storage.fetchGroup("slow", 10, false)
+	storage.compact()
+
+	// fetched before the compaction, the offset is from the old file
+	err = storage.commitGroup("slow", stale.NextOffset, stale.Generation)
+	if err != compactedSinceFetchError {
+		t.Logf("expected compactedSinceFetchError, got %v", err)
+		t.FailNow()
+	}
+	out, _ = storage.fetchGroup("slow", 1, false)
+	if len(out.Data) != 1 || string(out.Data[0]) != "50" || out.Generation != stale.Generation+1 {
+		t.Logf("unexpected data after compaction %v generation %d", out.Data, out.Generation)
+		t.FailNow()
+	}
+	groups = storage.listGroups().Groups
+	if groups[1].Offset != storage.offset {
+		t.Logf("expected committed offset at the end after compaction, got %d", groups[1].Offset)
+		t.FailNow()
+	}
+
+	// the generation survives a restart
+	storage.close()
+	storage = NewStorage(path, Durability{})
+	defer storage.close()
+	err = storage.commitGroup("slow", stale.NextOffset, stale.Generation)
+	if err != compactedSinceFetchError {
+		t.Logf("expected compactedSinceFetchError after reopening, got %v", err)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestLegacyHeader(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_legacy_header_test")
+	os.RemoveAll(root)
+	os.MkdirAll(root, 0700)
+
+	// written by a version before 3.0
+	legacy, _ := os.OpenFile(path.Join(root, "append.raw"), os.O_CREATE|os.O_RDWR, 0600)
+	offsets := []uint64{}
+	end := uint64(0)
+	for i := 0; i < 10; i++ {
+		value := []byte(fmt.Sprintf("value %d", i))
+		writeLegacyHeader(legacy, end, &Header{dataLen: uint32(len(value)), allocSize: uint32(len(value)) + 2, time: int64(i)})
+		legacy.WriteAt(value, int64(end+legacyHeaderLen))
+		offsets = append(offsets, end)
+		end += legacyHeaderLen + uint64(len(value)) + 2
+	}
+	legacy.Close()
+
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+	if storage.offset != end {
+		t.Logf("expected offset %d, got %d", end, storage.offset)
+		t.FailNow()
+	}
+	appended, _ := storage.append(0, []byte("value 10"))
+	offsets = append(offsets, appended)
+
+	expect := func(offsets []uint64) {
+		for i, offset := range offsets {
+			data, err := storage.read(offset)
+			if err != nil || string(data) != fmt.Sprintf("value %d", i) {
+				t.Logf("expected value %d at %d, got %s %v", i, offset, data, err)
+				t.FailNow()
+			}
+		}
+		scanned := 0
+		storage.scan(ScanOptions{}, func(offset uint64, header *Header, data []byte) bool {
+			scanned++
+			return true
+		})
+		if scanned != len(offsets) {
+			t.Logf("expected %d records in the scan, got %d", len(offsets), scanned)
+			t.FailNow()
+		}
+	}
+	expect(offsets)
+
+	err := storage.modify(offsets[3], 6, []byte("3"), false)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if err := storage.deleteRecord(offsets[3]); err != legacyRecordError {
+		t.Logf("expected legacyRecordError, got %v", err)
+		t.FailNow()
+	}
+	err = storage.modifyItem(&Modify{Offset: offsets[3], Data: []byte("x"), CheckVersion: true})
+	if err != legacyRecordError {
+		t.Logf("expected legacyRecordError, got %v", err)
+		t.FailNow()
+	}
+
+	// compaction upgrades the headers
+	relocationMap, err := storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	for i, offset := range offsets {
+		offsets[i] = relocationMap[offset]
+	}
+	expect(offsets)
+	header, _ := readHeader(storage.descriptor, offsets[0])
+	if header.legacy || header.flags&flagValueChecksum == 0 {
+		t.Logf("expected the header to be upgraded, got %v", header)
+		t.FailNow()
+	}
+	if err := storage.deleteRecord(offsets[3]); err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
+
+func TestUnrecognizedData(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_unrecognized_test")
+	os.RemoveAll(root)
+	os.MkdirAll(root, 0700)
+
+	garbage := bytes.Repeat([]byte{0xab}, 1200)
+	ioutil.WriteFile(path.Join(root, "append.raw"), garbage, 0600)
+
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+	for _, compact := range []func() (map[uint64]uint64, error){storage.compact, storage.compactOnline} {
+		_, err := compact()
+		if err != unrecognizedDataError {
+			t.Logf("expected unrecognizedDataError, got %v", err)
+			t.FailNow()
+		}
+	}
+	data, _ := ioutil.ReadFile(path.Join(root, "append.raw"))
+	if !bytes.Equal(data, garbage) {
+		t.Log("append.raw was modified")
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
+
+func TestValueChecksum(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_value_checksum_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+
+	first, _ := storage.append(16, []byte("first"), "a")
+	second, _ := storage.append(16, []byte("second"), "a")
+	storage.append(16, []byte("third"), "a")
+
+	err := storage.modify(first, 1, []byte("FF"), false)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	data, err := storage.read(first)
+	if err != nil || string(data) != "fFFst" {
+		t.Logf("unexpected %s %v", string(data), err)
+		t.FailNow()
+	}
+
+	// corrupt the value behind the back of the storage
+	storage.descriptor.WriteAt([]byte("X"), int64(second)+int64(headerLen))
+
+	_, err = storage.read(second)
+	if err != wrongValueChecksumError {
+		t.Logf("expected wrong value checksum, got %v", err)
+		t.FailNow()
+	}
+
+	found := 0
+	_, err = storage.scan(ScanOptions{skipCorrupt: true}, func(offset uint64, header *Header, data []byte) bool {
+		found++
+		return true
+	})
+	if err != nil || found != 2 {
+		t.Logf("expected 2 records when skipping, got %d, err: %v", found, err)
+		t.FailNow()
+	}
+
+	found = 0
+	_, err = storage.scan(ScanOptions{}, func(offset uint64, header *Header, data []byte) bool {
+		found++
+		return true
+	})
+	if err == nil || found != 1 {
+		t.Logf("expected an error after 1 record, got %d, err: %v", found, err)
+		t.FailNow()
+	}
+
+	found = 0
+	err = storage.ExecuteQuery(storage.GetPostingsList("a").newTermQuery(), QueryOptions{skipCorrupt: true}, func(offset uint64, header *Header, data []byte) bool {
+		found++
+		return true
+	})
+	if err != nil || found != 2 {
+		t.Logf("expected 2 hits when skipping, got %d, err: %v", found, err)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+// the value and its checksum are not written at once, a read during a
+// modify must still see a valid record
+func TestReadDuringModify(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_read_during_modify_test")
+	os.RemoveAll(root)
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+
+	offset, _ := storage.append(1024, bytes.Repeat([]byte("a"), 1024))
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for i := 0; i < 2000; i++ {
+			value := bytes.Repeat([]byte{byte('a' + i%26)}, 1024)
+			err := storage.modify(offset, 0, value, false)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		data, err := storage.read(offset)
+		if err != nil || len(data) != 1024 || !bytes.Equal(data, bytes.Repeat(data[:1], 1024)) {
+			t.Logf("unexpected read during modify: %v", err)
+			t.FailNow()
+		}
+	}
+	os.RemoveAll(root)
+}
@@ -10,15 +10,32 @@
 	It has these top-level messages:
 		Modify
 		Append
+		Delete
 		AppendInput
+		ItemStatus
 		AppendOutput
 		NamespaceInput
+		CreateInput
+		CompactInput
 		SuccessOutput
 		Get
 		GetInput
 		ScanOutput
 		GetOutput
+		FetchInput
+		FetchOutput
+		CommitInput
+		Group
+		GroupsOutput
 		StatsOutput
+		CountOutput
+		TagsInput
+		Tag
+		TagsOutput
+		VerifyInput
+		VerifyOutput
+		ExplainNode
+		ExplainOutput
 */
 package main
 
@@ -32,6 +49,8 @@ import strings "strings"
 import reflect "reflect"
 import sortkeys "github.com/gogo/protobuf/sortkeys"
 
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -46,11 +65,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Modify struct {
-	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Pos         int32  `protobuf:"varint,2,opt,name=pos,proto3" json:"pos,omitempty"`
-	Offset      uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Data        []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	ResetLength bool   `protobuf:"varint,5,opt,name=resetLength,proto3" json:"resetLength,omitempty"`
+	Namespace       string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pos             int32  `protobuf:"varint,2,opt,name=pos,proto3" json:"pos,omitempty"`
+	Offset          uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data            []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	ResetLength     bool   `protobuf:"varint,5,opt,name=resetLength,proto3" json:"resetLength,omitempty"`
+	Key             string `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
+	CheckVersion    bool   `protobuf:"varint,7,opt,name=checkVersion,proto3" json:"checkVersion,omitempty"`
+	ExpectedVersion uint32 `protobuf:"varint,8,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
 func (m *Modify) Reset()                    { *m = Modify{} }
@@ -92,11 +114,34 @@ func (m *Modify) GetResetLength() bool {
 	return false
 }
 
+func (m *Modify) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Modify) GetCheckVersion() bool {
+	if m != nil {
+		return m.CheckVersion
+	}
+	return false
+}
+
+func (m *Modify) GetExpectedVersion() uint32 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type Append struct {
-	Namespace string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	AllocSize uint32   `protobuf:"varint,2,opt,name=allocSize,proto3" json:"allocSize,omitempty"`
-	Tags      []string `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty"`
-	Data      []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Namespace string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	AllocSize uint32             `protobuf:"varint,2,opt,name=allocSize,proto3" json:"allocSize,omitempty"`
+	Tags      []string           `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty"`
+	Data      []byte             `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Fields    map[string]float64 `protobuf:"bytes,6,rep,name=fields" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Key       string             `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
 }
 
 func (m *Append) Reset()                    { *m = Append{} }
@@ -131,14 +176,54 @@ func (m *Append) GetData() []byte {
 	return nil
 }
 
+func (m *Append) GetFields() map[string]float64 {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *Append) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type Delete struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Offset    uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *Delete) Reset()                    { *m = Delete{} }
+func (*Delete) ProtoMessage()               {}
+func (*Delete) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{2} }
+
+func (m *Delete) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Delete) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type AppendInput struct {
 	AppendPayload []*Append `protobuf:"bytes,1,rep,name=appendPayload" json:"appendPayload,omitempty"`
 	ModifyPayload []*Modify `protobuf:"bytes,2,rep,name=modifyPayload" json:"modifyPayload,omitempty"`
+	DeletePayload []*Delete `protobuf:"bytes,3,rep,name=deletePayload" json:"deletePayload,omitempty"`
+	Transactional bool      `protobuf:"varint,4,opt,name=transactional,proto3" json:"transactional,omitempty"`
+	WithStatus    bool      `protobuf:"varint,5,opt,name=withStatus,proto3" json:"withStatus,omitempty"`
 }
 
 func (m *AppendInput) Reset()                    { *m = AppendInput{} }
 func (*AppendInput) ProtoMessage()               {}
-func (*AppendInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{2} }
+func (*AppendInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{3} }
 
 func (m *AppendInput) GetAppendPayload() []*Append {
 	if m != nil {
@@ -154,14 +239,62 @@ func (m *AppendInput) GetModifyPayload() []*Modify {
 	return nil
 }
 
+func (m *AppendInput) GetDeletePayload() []*Delete {
+	if m != nil {
+		return m.DeletePayload
+	}
+	return nil
+}
+
+func (m *AppendInput) GetTransactional() bool {
+	if m != nil {
+		return m.Transactional
+	}
+	return false
+}
+
+func (m *AppendInput) GetWithStatus() bool {
+	if m != nil {
+		return m.WithStatus
+	}
+	return false
+}
+
+type ItemStatus struct {
+	Code  uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ItemStatus) Reset()                    { *m = ItemStatus{} }
+func (*ItemStatus) ProtoMessage()               {}
+func (*ItemStatus) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{4} }
+
+func (m *ItemStatus) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ItemStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type AppendOutput struct {
-	Offset        []uint64 `protobuf:"varint,1,rep,packed,name=offset" json:"offset,omitempty"`
-	ModifiedCount uint64   `protobuf:"varint,2,opt,name=modifiedCount,proto3" json:"modifiedCount,omitempty"`
+	Offset        []uint64      `protobuf:"varint,1,rep,packed,name=offset" json:"offset,omitempty"`
+	ModifiedCount uint64        `protobuf:"varint,2,opt,name=modifiedCount,proto3" json:"modifiedCount,omitempty"`
+	DeletedCount  uint64        `protobuf:"varint,3,opt,name=deletedCount,proto3" json:"deletedCount,omitempty"`
+	AppendStatus  []*ItemStatus `protobuf:"bytes,4,rep,name=appendStatus" json:"appendStatus,omitempty"`
+	ModifyStatus  []*ItemStatus `protobuf:"bytes,5,rep,name=modifyStatus" json:"modifyStatus,omitempty"`
+	DeleteStatus  []*ItemStatus `protobuf:"bytes,6,rep,name=deleteStatus" json:"deleteStatus,omitempty"`
 }
 
 func (m *AppendOutput) Reset()                    { *m = AppendOutput{} }
 func (*AppendOutput) ProtoMessage()               {}
-func (*AppendOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{3} }
+func (*AppendOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{5} }
 
 func (m *AppendOutput) GetOffset() []uint64 {
 	if m != nil {
@@ -177,13 +310,41 @@ func (m *AppendOutput) GetModifiedCount() uint64 {
 	return 0
 }
 
+func (m *AppendOutput) GetDeletedCount() uint64 {
+	if m != nil {
+		return m.DeletedCount
+	}
+	return 0
+}
+
+func (m *AppendOutput) GetAppendStatus() []*ItemStatus {
+	if m != nil {
+		return m.AppendStatus
+	}
+	return nil
+}
+
+func (m *AppendOutput) GetModifyStatus() []*ItemStatus {
+	if m != nil {
+		return m.ModifyStatus
+	}
+	return nil
+}
+
+func (m *AppendOutput) GetDeleteStatus() []*ItemStatus {
+	if m != nil {
+		return m.DeleteStatus
+	}
+	return nil
+}
+
 type NamespaceInput struct {
 	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *NamespaceInput) Reset()                    { *m = NamespaceInput{} }
 func (*NamespaceInput) ProtoMessage()               {}
-func (*NamespaceInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{4} }
+func (*NamespaceInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{6} }
 
 func (m *NamespaceInput) GetNamespace() string {
 	if m != nil {
@@ -192,13 +353,67 @@ func (m *NamespaceInput) GetNamespace() string {
 	return ""
 }
 
+type CreateInput struct {
+	Namespace    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Durability   string `protobuf:"bytes,2,opt,name=durability,proto3" json:"durability,omitempty"`
+	SyncInterval uint32 `protobuf:"varint,3,opt,name=syncInterval,proto3" json:"syncInterval,omitempty"`
+}
+
+func (m *CreateInput) Reset()                    { *m = CreateInput{} }
+func (*CreateInput) ProtoMessage()               {}
+func (*CreateInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{7} }
+
+func (m *CreateInput) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CreateInput) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
+func (m *CreateInput) GetSyncInterval() uint32 {
+	if m != nil {
+		return m.SyncInterval
+	}
+	return 0
+}
+
+type CompactInput struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Online    bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+}
+
+func (m *CompactInput) Reset()                    { *m = CompactInput{} }
+func (*CompactInput) ProtoMessage()               {}
+func (*CompactInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{8} }
+
+func (m *CompactInput) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CompactInput) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
 type SuccessOutput struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
 func (m *SuccessOutput) Reset()                    { *m = SuccessOutput{} }
 func (*SuccessOutput) ProtoMessage()               {}
-func (*SuccessOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{5} }
+func (*SuccessOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{9} }
 
 func (m *SuccessOutput) GetSuccess() bool {
 	if m != nil {
@@ -210,11 +425,12 @@ func (m *SuccessOutput) GetSuccess() bool {
 type Get struct {
 	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Offset    uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
 func (m *Get) Reset()                    { *m = Get{} }
 func (*Get) ProtoMessage()               {}
-func (*Get) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{6} }
+func (*Get) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{10} }
 
 func (m *Get) GetNamespace() string {
 	if m != nil {
@@ -230,13 +446,23 @@ func (m *Get) GetOffset() uint64 {
 	return 0
 }
 
+func (m *Get) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 type GetInput struct {
-	GetPayload []*Get `protobuf:"bytes,1,rep,name=getPayload" json:"getPayload,omitempty"`
+	GetPayload  []*Get `protobuf:"bytes,1,rep,name=getPayload" json:"getPayload,omitempty"`
+	SkipCorrupt bool   `protobuf:"varint,2,opt,name=skipCorrupt,proto3" json:"skipCorrupt,omitempty"`
+	WithTime    bool   `protobuf:"varint,3,opt,name=withTime,proto3" json:"withTime,omitempty"`
+	WithVersion bool   `protobuf:"varint,4,opt,name=withVersion,proto3" json:"withVersion,omitempty"`
 }
 
 func (m *GetInput) Reset()                    { *m = GetInput{} }
 func (*GetInput) ProtoMessage()               {}
-func (*GetInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{7} }
+func (*GetInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{11} }
 
 func (m *GetInput) GetGetPayload() []*Get {
 	if m != nil {
@@ -245,6 +471,27 @@ func (m *GetInput) GetGetPayload() []*Get {
 	return nil
 }
 
+func (m *GetInput) GetSkipCorrupt() bool {
+	if m != nil {
+		return m.SkipCorrupt
+	}
+	return false
+}
+
+func (m *GetInput) GetWithTime() bool {
+	if m != nil {
+		return m.WithTime
+	}
+	return false
+}
+
+func (m *GetInput) GetWithVersion() bool {
+	if m != nil {
+		return m.WithVersion
+	}
+	return false
+}
+
 type ScanOutput struct {
 	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
@@ -252,7 +499,7 @@ type ScanOutput struct {
 
 func (m *ScanOutput) Reset()                    { *m = ScanOutput{} }
 func (*ScanOutput) ProtoMessage()               {}
-func (*ScanOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{8} }
+func (*ScanOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{12} }
 
 func (m *ScanOutput) GetData() []byte {
 	if m != nil {
@@ -269,12 +516,14 @@ func (m *ScanOutput) GetOffset() uint64 {
 }
 
 type GetOutput struct {
-	Data [][]byte `protobuf:"bytes,1,rep,name=data" json:"data,omitempty"`
+	Data    [][]byte `protobuf:"bytes,1,rep,name=data" json:"data,omitempty"`
+	Time    []int64  `protobuf:"varint,2,rep,packed,name=time" json:"time,omitempty"`
+	Version []uint32 `protobuf:"varint,3,rep,packed,name=version" json:"version,omitempty"`
 }
 
 func (m *GetOutput) Reset()                    { *m = GetOutput{} }
 func (*GetOutput) ProtoMessage()               {}
-func (*GetOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{9} }
+func (*GetOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{13} }
 
 func (m *GetOutput) GetData() [][]byte {
 	if m != nil {
@@ -283,249 +532,524 @@ func (m *GetOutput) GetData() [][]byte {
 	return nil
 }
 
-type StatsOutput struct {
-	Tags   map[string]uint64 `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Offset uint64            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	File   string            `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+func (m *GetOutput) GetTime() []int64 {
+	if m != nil {
+		return m.Time
+	}
+	return nil
 }
 
-func (m *StatsOutput) Reset()                    { *m = StatsOutput{} }
-func (*StatsOutput) ProtoMessage()               {}
-func (*StatsOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{10} }
-
-func (m *StatsOutput) GetTags() map[string]uint64 {
+func (m *GetOutput) GetVersion() []uint32 {
 	if m != nil {
-		return m.Tags
+		return m.Version
 	}
 	return nil
 }
 
-func (m *StatsOutput) GetOffset() uint64 {
+type FetchInput struct {
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Group       string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Limit       uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	SkipCorrupt bool   `protobuf:"varint,4,opt,name=skipCorrupt,proto3" json:"skipCorrupt,omitempty"`
+}
+
+func (m *FetchInput) Reset()                    { *m = FetchInput{} }
+func (*FetchInput) ProtoMessage()               {}
+func (*FetchInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{14} }
+
+func (m *FetchInput) GetNamespace() string {
 	if m != nil {
-		return m.Offset
+		return m.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (m *StatsOutput) GetFile() string {
+func (m *FetchInput) GetGroup() string {
 	if m != nil {
-		return m.File
+		return m.Group
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Modify)(nil), "main.Modify")
-	proto.RegisterType((*Append)(nil), "main.Append")
-	proto.RegisterType((*AppendInput)(nil), "main.AppendInput")
-	proto.RegisterType((*AppendOutput)(nil), "main.AppendOutput")
-	proto.RegisterType((*NamespaceInput)(nil), "main.NamespaceInput")
-	proto.RegisterType((*SuccessOutput)(nil), "main.SuccessOutput")
-	proto.RegisterType((*Get)(nil), "main.Get")
-	proto.RegisterType((*GetInput)(nil), "main.GetInput")
-	proto.RegisterType((*ScanOutput)(nil), "main.ScanOutput")
-	proto.RegisterType((*GetOutput)(nil), "main.GetOutput")
-	proto.RegisterType((*StatsOutput)(nil), "main.StatsOutput")
-}
-func (this *Modify) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *FetchInput) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
+	return 0
+}
 
-	that1, ok := that.(*Modify)
-	if !ok {
-		that2, ok := that.(Modify)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *FetchInput) GetSkipCorrupt() bool {
+	if m != nil {
+		return m.SkipCorrupt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+type FetchOutput struct {
+	Data       [][]byte `protobuf:"bytes,1,rep,name=data" json:"data,omitempty"`
+	Offset     []uint64 `protobuf:"varint,2,rep,packed,name=offset" json:"offset,omitempty"`
+	NextOffset uint64   `protobuf:"varint,3,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+	Generation uint64   `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+}
+
+func (m *FetchOutput) Reset()                    { *m = FetchOutput{} }
+func (*FetchOutput) ProtoMessage()               {}
+func (*FetchOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{15} }
+
+func (m *FetchOutput) GetData() [][]byte {
+	if m != nil {
+		return m.Data
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return nil
+}
+
+func (m *FetchOutput) GetOffset() []uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	if this.Pos != that1.Pos {
-		return false
+	return nil
+}
+
+func (m *FetchOutput) GetNextOffset() uint64 {
+	if m != nil {
+		return m.NextOffset
 	}
-	if this.Offset != that1.Offset {
-		return false
+	return 0
+}
+
+func (m *FetchOutput) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
+	return 0
+}
+
+type CommitInput struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Group      string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Offset     uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Generation uint64 `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+}
+
+func (m *CommitInput) Reset()                    { *m = CommitInput{} }
+func (*CommitInput) ProtoMessage()               {}
+func (*CommitInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{16} }
+
+func (m *CommitInput) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.ResetLength != that1.ResetLength {
-		return false
+	return ""
+}
+
+func (m *CommitInput) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return true
+	return ""
 }
-func (this *Append) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CommitInput) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
+	return 0
+}
 
-	that1, ok := that.(*Append)
-	if !ok {
-		that2, ok := that.(Append)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
+func (m *CommitInput) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
 	}
-	if this.AllocSize != that1.AllocSize {
-		return false
+	return 0
+}
+
+type Group struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Lag    uint64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+}
+
+func (m *Group) Reset()                    { *m = Group{} }
+func (*Group) ProtoMessage()               {}
+func (*Group) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{17} }
+
+func (m *Group) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(this.Tags) != len(that1.Tags) {
-		return false
+	return ""
+}
+
+func (m *Group) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	for i := range this.Tags {
-		if this.Tags[i] != that1.Tags[i] {
-			return false
-		}
+	return 0
+}
+
+func (m *Group) GetLag() uint64 {
+	if m != nil {
+		return m.Lag
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
+	return 0
+}
+
+type GroupsOutput struct {
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (m *GroupsOutput) Reset()                    { *m = GroupsOutput{} }
+func (*GroupsOutput) ProtoMessage()               {}
+func (*GroupsOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{18} }
+
+func (m *GroupsOutput) GetGroups() []*Group {
+	if m != nil {
+		return m.Groups
 	}
-	return true
+	return nil
 }
-func (this *AppendInput) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+type StatsOutput struct {
+	Tags   map[string]uint64 `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Offset uint64            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	File   string            `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (m *StatsOutput) Reset()                    { *m = StatsOutput{} }
+func (*StatsOutput) ProtoMessage()               {}
+func (*StatsOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{19} }
+
+func (m *StatsOutput) GetTags() map[string]uint64 {
+	if m != nil {
+		return m.Tags
 	}
+	return nil
+}
 
-	that1, ok := that.(*AppendInput)
-	if !ok {
-		that2, ok := that.(AppendInput)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *StatsOutput) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *StatsOutput) GetFile() string {
+	if m != nil {
+		return m.File
 	}
-	if len(this.AppendPayload) != len(that1.AppendPayload) {
-		return false
+	return ""
+}
+
+type CountOutput struct {
+	Count  uint64            `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Facets map[string]uint64 `protobuf:"bytes,2,rep,name=facets" json:"facets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *CountOutput) Reset()                    { *m = CountOutput{} }
+func (*CountOutput) ProtoMessage()               {}
+func (*CountOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{20} }
+
+func (m *CountOutput) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	for i := range this.AppendPayload {
-		if !this.AppendPayload[i].Equal(that1.AppendPayload[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *CountOutput) GetFacets() map[string]uint64 {
+	if m != nil {
+		return m.Facets
 	}
-	if len(this.ModifyPayload) != len(that1.ModifyPayload) {
-		return false
+	return nil
+}
+
+type TagsInput struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Prefix    string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Sort      string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	From      uint32 `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	Limit     uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *TagsInput) Reset()                    { *m = TagsInput{} }
+func (*TagsInput) ProtoMessage()               {}
+func (*TagsInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{21} }
+
+func (m *TagsInput) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	for i := range this.ModifyPayload {
-		if !this.ModifyPayload[i].Equal(that1.ModifyPayload[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *TagsInput) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return true
+	return ""
 }
-func (this *AppendOutput) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TagsInput) GetSort() string {
+	if m != nil {
+		return m.Sort
 	}
+	return ""
+}
 
-	that1, ok := that.(*AppendOutput)
-	if !ok {
-		that2, ok := that.(AppendOutput)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *TagsInput) GetFrom() uint32 {
+	if m != nil {
+		return m.From
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *TagsInput) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	if len(this.Offset) != len(that1.Offset) {
-		return false
+	return 0
+}
+
+type Tag struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *Tag) Reset()                    { *m = Tag{} }
+func (*Tag) ProtoMessage()               {}
+func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{22} }
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	for i := range this.Offset {
-		if this.Offset[i] != that1.Offset[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *Tag) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if this.ModifiedCount != that1.ModifiedCount {
-		return false
+	return 0
+}
+
+type TagsOutput struct {
+	Tags     []*Tag `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
+	Total    uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextFrom uint32 `protobuf:"varint,3,opt,name=nextFrom,proto3" json:"nextFrom,omitempty"`
+}
+
+func (m *TagsOutput) Reset()                    { *m = TagsOutput{} }
+func (*TagsOutput) ProtoMessage()               {}
+func (*TagsOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{23} }
+
+func (m *TagsOutput) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
 	}
-	return true
+	return nil
 }
-func (this *NamespaceInput) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TagsOutput) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
+	return 0
+}
 
-	that1, ok := that.(*NamespaceInput)
-	if !ok {
-		that2, ok := that.(NamespaceInput)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *TagsOutput) GetNextFrom() uint32 {
+	if m != nil {
+		return m.NextFrom
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+type VerifyInput struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Repair    bool   `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+}
+
+func (m *VerifyInput) Reset()                    { *m = VerifyInput{} }
+func (*VerifyInput) ProtoMessage()               {}
+func (*VerifyInput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{24} }
+
+func (m *VerifyInput) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return ""
+}
+
+func (m *VerifyInput) GetRepair() bool {
+	if m != nil {
+		return m.Repair
 	}
-	return true
+	return false
 }
-func (this *SuccessOutput) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+type VerifyOutput struct {
+	Unsorted []string `protobuf:"bytes,1,rep,name=unsorted" json:"unsorted,omitempty"`
+	Repaired bool     `protobuf:"varint,2,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (m *VerifyOutput) Reset()                    { *m = VerifyOutput{} }
+func (*VerifyOutput) ProtoMessage()               {}
+func (*VerifyOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{25} }
+
+func (m *VerifyOutput) GetUnsorted() []string {
+	if m != nil {
+		return m.Unsorted
 	}
+	return nil
+}
 
-	that1, ok := that.(*SuccessOutput)
-	if !ok {
-		that2, ok := that.(SuccessOutput)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *VerifyOutput) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+type ExplainNode struct {
+	Type     string         `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Tag      string         `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Cost     int64          `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Next     uint64         `protobuf:"varint,4,opt,name=next,proto3" json:"next,omitempty"`
+	Advance  uint64         `protobuf:"varint,5,opt,name=advance,proto3" json:"advance,omitempty"`
+	Docs     uint64         `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
+	Children []*ExplainNode `protobuf:"bytes,7,rep,name=children" json:"children,omitempty"`
+}
+
+func (m *ExplainNode) Reset()                    { *m = ExplainNode{} }
+func (*ExplainNode) ProtoMessage()               {}
+func (*ExplainNode) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{26} }
+
+func (m *ExplainNode) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if this.Success != that1.Success {
-		return false
+	return ""
+}
+
+func (m *ExplainNode) GetTag() string {
+	if m != nil {
+		return m.Tag
 	}
-	return true
+	return ""
 }
-func (this *Get) Equal(that interface{}) bool {
+
+func (m *ExplainNode) GetCost() int64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *ExplainNode) GetNext() uint64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *ExplainNode) GetAdvance() uint64 {
+	if m != nil {
+		return m.Advance
+	}
+	return 0
+}
+
+func (m *ExplainNode) GetDocs() uint64 {
+	if m != nil {
+		return m.Docs
+	}
+	return 0
+}
+
+func (m *ExplainNode) GetChildren() []*ExplainNode {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type ExplainOutput struct {
+	Query           *ExplainNode `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	Matches         uint64       `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	BytesRead       uint64       `protobuf:"varint,3,opt,name=bytesRead,proto3" json:"bytesRead,omitempty"`
+	TookNanoseconds int64        `protobuf:"varint,4,opt,name=tookNanoseconds,proto3" json:"tookNanoseconds,omitempty"`
+}
+
+func (m *ExplainOutput) Reset()                    { *m = ExplainOutput{} }
+func (*ExplainOutput) ProtoMessage()               {}
+func (*ExplainOutput) Descriptor() ([]byte, []int) { return fileDescriptorInput, []int{27} }
+
+func (m *ExplainOutput) GetQuery() *ExplainNode {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ExplainOutput) GetMatches() uint64 {
+	if m != nil {
+		return m.Matches
+	}
+	return 0
+}
+
+func (m *ExplainOutput) GetBytesRead() uint64 {
+	if m != nil {
+		return m.BytesRead
+	}
+	return 0
+}
+
+func (m *ExplainOutput) GetTookNanoseconds() int64 {
+	if m != nil {
+		return m.TookNanoseconds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Modify)(nil), "main.Modify")
+	proto.RegisterType((*Append)(nil), "main.Append")
+	proto.RegisterType((*Delete)(nil), "main.Delete")
+	proto.RegisterType((*AppendInput)(nil), "main.AppendInput")
+	proto.RegisterType((*ItemStatus)(nil), "main.ItemStatus")
+	proto.RegisterType((*AppendOutput)(nil), "main.AppendOutput")
+	proto.RegisterType((*NamespaceInput)(nil), "main.NamespaceInput")
+	proto.RegisterType((*CreateInput)(nil), "main.CreateInput")
+	proto.RegisterType((*CompactInput)(nil), "main.CompactInput")
+	proto.RegisterType((*SuccessOutput)(nil), "main.SuccessOutput")
+	proto.RegisterType((*Get)(nil), "main.Get")
+	proto.RegisterType((*GetInput)(nil), "main.GetInput")
+	proto.RegisterType((*ScanOutput)(nil), "main.ScanOutput")
+	proto.RegisterType((*GetOutput)(nil), "main.GetOutput")
+	proto.RegisterType((*FetchInput)(nil), "main.FetchInput")
+	proto.RegisterType((*FetchOutput)(nil), "main.FetchOutput")
+	proto.RegisterType((*CommitInput)(nil), "main.CommitInput")
+	proto.RegisterType((*Group)(nil), "main.Group")
+	proto.RegisterType((*GroupsOutput)(nil), "main.GroupsOutput")
+	proto.RegisterType((*StatsOutput)(nil), "main.StatsOutput")
+	proto.RegisterType((*CountOutput)(nil), "main.CountOutput")
+	proto.RegisterType((*TagsInput)(nil), "main.TagsInput")
+	proto.RegisterType((*Tag)(nil), "main.Tag")
+	proto.RegisterType((*TagsOutput)(nil), "main.TagsOutput")
+	proto.RegisterType((*VerifyInput)(nil), "main.VerifyInput")
+	proto.RegisterType((*VerifyOutput)(nil), "main.VerifyOutput")
+	proto.RegisterType((*ExplainNode)(nil), "main.ExplainNode")
+	proto.RegisterType((*ExplainOutput)(nil), "main.ExplainOutput")
+}
+func (this *Modify) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Get)
+	that1, ok := that.(*Modify)
 	if !ok {
-		that2, ok := that.(Get)
+		that2, ok := that.(Modify)
 		if ok {
 			that1 = &that2
 		} else {
@@ -540,19 +1064,37 @@ func (this *Get) Equal(that interface{}) bool {
 	if this.Namespace != that1.Namespace {
 		return false
 	}
+	if this.Pos != that1.Pos {
+		return false
+	}
 	if this.Offset != that1.Offset {
 		return false
 	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.ResetLength != that1.ResetLength {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.CheckVersion != that1.CheckVersion {
+		return false
+	}
+	if this.ExpectedVersion != that1.ExpectedVersion {
+		return false
+	}
 	return true
 }
-func (this *GetInput) Equal(that interface{}) bool {
+func (this *Append) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetInput)
+	that1, ok := that.(*Append)
 	if !ok {
-		that2, ok := that.(GetInput)
+		that2, ok := that.(Append)
 		if ok {
 			that1 = &that2
 		} else {
@@ -564,24 +1106,44 @@ func (this *GetInput) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.GetPayload) != len(that1.GetPayload) {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	for i := range this.GetPayload {
-		if !this.GetPayload[i].Equal(that1.GetPayload[i]) {
+	if this.AllocSize != that1.AllocSize {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
 			return false
 		}
 	}
+	if this.Key != that1.Key {
+		return false
+	}
 	return true
 }
-func (this *ScanOutput) Equal(that interface{}) bool {
+func (this *Delete) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ScanOutput)
+	that1, ok := that.(*Delete)
 	if !ok {
-		that2, ok := that.(ScanOutput)
+		that2, ok := that.(Delete)
 		if ok {
 			that1 = &that2
 		} else {
@@ -593,7 +1155,7 @@ func (this *ScanOutput) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
 	if this.Offset != that1.Offset {
@@ -601,14 +1163,14 @@ func (this *ScanOutput) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *GetOutput) Equal(that interface{}) bool {
+func (this *AppendInput) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetOutput)
+	that1, ok := that.(*AppendInput)
 	if !ok {
-		that2, ok := that.(GetOutput)
+		that2, ok := that.(AppendInput)
 		if ok {
 			that1 = &that2
 		} else {
@@ -620,24 +1182,46 @@ func (this *GetOutput) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Data) != len(that1.Data) {
+	if len(this.AppendPayload) != len(that1.AppendPayload) {
 		return false
 	}
-	for i := range this.Data {
-		if !bytes.Equal(this.Data[i], that1.Data[i]) {
+	for i := range this.AppendPayload {
+		if !this.AppendPayload[i].Equal(that1.AppendPayload[i]) {
 			return false
 		}
 	}
+	if len(this.ModifyPayload) != len(that1.ModifyPayload) {
+		return false
+	}
+	for i := range this.ModifyPayload {
+		if !this.ModifyPayload[i].Equal(that1.ModifyPayload[i]) {
+			return false
+		}
+	}
+	if len(this.DeletePayload) != len(that1.DeletePayload) {
+		return false
+	}
+	for i := range this.DeletePayload {
+		if !this.DeletePayload[i].Equal(that1.DeletePayload[i]) {
+			return false
+		}
+	}
+	if this.Transactional != that1.Transactional {
+		return false
+	}
+	if this.WithStatus != that1.WithStatus {
+		return false
+	}
 	return true
 }
-func (this *StatsOutput) Equal(that interface{}) bool {
+func (this *ItemStatus) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StatsOutput)
+	that1, ok := that.(*ItemStatus)
 	if !ok {
-		that2, ok := that.(StatsOutput)
+		that2, ok := that.(ItemStatus)
 		if ok {
 			that1 = &that2
 		} else {
@@ -649,898 +1233,5645 @@ func (this *StatsOutput) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Tags) != len(that1.Tags) {
+	if this.Code != that1.Code {
 		return false
 	}
-	for i := range this.Tags {
-		if this.Tags[i] != that1.Tags[i] {
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *AppendOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AppendOutput)
+	if !ok {
+		that2, ok := that.(AppendOutput)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.Offset != that1.Offset {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.File != that1.File {
+	if len(this.Offset) != len(that1.Offset) {
 		return false
 	}
-	return true
-}
-func (this *Modify) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Offset {
+		if this.Offset[i] != that1.Offset[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&main.Modify{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "Pos: "+fmt.Sprintf("%#v", this.Pos)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "ResetLength: "+fmt.Sprintf("%#v", this.ResetLength)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Append) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ModifiedCount != that1.ModifiedCount {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&main.Append{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "AllocSize: "+fmt.Sprintf("%#v", this.AllocSize)+",\n")
-	s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AppendInput) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.DeletedCount != that1.DeletedCount {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&main.AppendInput{")
-	if this.AppendPayload != nil {
-		s = append(s, "AppendPayload: "+fmt.Sprintf("%#v", this.AppendPayload)+",\n")
+	if len(this.AppendStatus) != len(that1.AppendStatus) {
+		return false
 	}
-	if this.ModifyPayload != nil {
-		s = append(s, "ModifyPayload: "+fmt.Sprintf("%#v", this.ModifyPayload)+",\n")
+	for i := range this.AppendStatus {
+		if !this.AppendStatus[i].Equal(that1.AppendStatus[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AppendOutput) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.ModifyStatus) != len(that1.ModifyStatus) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&main.AppendOutput{")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "ModifiedCount: "+fmt.Sprintf("%#v", this.ModifiedCount)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *NamespaceInput) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.ModifyStatus {
+		if !this.ModifyStatus[i].Equal(that1.ModifyStatus[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&main.NamespaceInput{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SuccessOutput) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.DeleteStatus) != len(that1.DeleteStatus) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&main.SuccessOutput{")
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	for i := range this.DeleteStatus {
+		if !this.DeleteStatus[i].Equal(that1.DeleteStatus[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (this *Get) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *NamespaceInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&main.Get{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetInput) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*NamespaceInput)
+	if !ok {
+		that2, ok := that.(NamespaceInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&main.GetInput{")
-	if this.GetPayload != nil {
-		s = append(s, "GetPayload: "+fmt.Sprintf("%#v", this.GetPayload)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ScanOutput) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&main.ScanOutput{")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetOutput) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CreateInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&main.GetOutput{")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *StatsOutput) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*CreateInput)
+	if !ok {
+		that2, ok := that.(CreateInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&main.StatsOutput{")
-	keysForTags := make([]string, 0, len(this.Tags))
-	for k, _ := range this.Tags {
-		keysForTags = append(keysForTags, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	sortkeys.Strings(keysForTags)
-	mapStringForTags := "map[string]uint64{"
-	for _, k := range keysForTags {
-		mapStringForTags += fmt.Sprintf("%#v: %#v,", k, this.Tags[k])
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	mapStringForTags += "}"
-	if this.Tags != nil {
-		s = append(s, "Tags: "+mapStringForTags+",\n")
+	if this.Durability != that1.Durability {
+		return false
 	}
-	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
-	s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringInput(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.SyncInterval != that1.SyncInterval {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func (m *Modify) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *CompactInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Modify) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+	that1, ok := that.(*CompactInput)
+	if !ok {
+		that2, ok := that.(CompactInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Pos != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.Pos))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if this.Online != that1.Online {
+		return false
 	}
-	if m.ResetLength {
-		dAtA[i] = 0x28
-		i++
-		if m.ResetLength {
-			dAtA[i] = 1
+	return true
+}
+func (this *SuccessOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SuccessOutput)
+	if !ok {
+		that2, ok := that.(SuccessOutput)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *Append) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Success != that1.Success {
+		return false
+	}
+	return true
 }
+func (this *Get) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Append) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+	that1, ok := that.(*Get)
+	if !ok {
+		that2, ok := that.(Get)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.AllocSize != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.AllocSize))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if this.Offset != that1.Offset {
+		return false
 	}
-	return i, nil
-}
-
-func (m *AppendInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Key != that1.Key {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *GetInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AppendInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppendPayload) > 0 {
-		for _, msg := range m.AppendPayload {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	that1, ok := that.(*GetInput)
+	if !ok {
+		that2, ok := that.(GetInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ModifyPayload) > 0 {
-		for _, msg := range m.ModifyPayload {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *AppendOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(this.GetPayload) != len(that1.GetPayload) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AppendOutput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Offset) > 0 {
-		dAtA2 := make([]byte, len(m.Offset)*10)
-		var j1 int
-		for _, num := range m.Offset {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+	for i := range this.GetPayload {
+		if !this.GetPayload[i].Equal(that1.GetPayload[i]) {
+			return false
 		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(j1))
-		i += copy(dAtA[i:], dAtA2[:j1])
 	}
-	if m.ModifiedCount != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.ModifiedCount))
+	if this.SkipCorrupt != that1.SkipCorrupt {
+		return false
 	}
-	return i, nil
-}
-
-func (m *NamespaceInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.WithTime != that1.WithTime {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NamespaceInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+	if this.WithVersion != that1.WithVersion {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *SuccessOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ScanOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *SuccessOutput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		dAtA[i] = 0x8
-		i++
-		if m.Success {
-			dAtA[i] = 1
+	that1, ok := that.(*ScanOutput)
+	if !ok {
+		that2, ok := that.(ScanOutput)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *Get) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Get) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	if this.Offset != that1.Offset {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *GetInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *GetOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *GetInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.GetPayload) > 0 {
-		for _, msg := range m.GetPayload {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	that1, ok := that.(*GetOutput)
+	if !ok {
+		that2, ok := that.(GetOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return i, nil
-}
-
-func (m *ScanOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScanOutput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if len(this.Data) != len(that1.Data) {
+		return false
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	for i := range this.Data {
+		if !bytes.Equal(this.Data[i], that1.Data[i]) {
+			return false
+		}
 	}
-	return i, nil
+	if len(this.Time) != len(that1.Time) {
+		return false
+	}
+	for i := range this.Time {
+		if this.Time[i] != that1.Time[i] {
+			return false
+		}
+	}
+	if len(this.Version) != len(that1.Version) {
+		return false
+	}
+	for i := range this.Version {
+		if this.Version[i] != that1.Version[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *FetchInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*FetchInput)
+	if !ok {
+		that2, ok := that.(FetchInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Group != that1.Group {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.SkipCorrupt != that1.SkipCorrupt {
+		return false
+	}
+	return true
 }
+func (this *FetchOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetOutput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for _, b := range m.Data {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintInput(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+	that1, ok := that.(*FetchOutput)
+	if !ok {
+		that2, ok := that.(FetchOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return i, nil
-}
-
-func (m *StatsOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StatsOutput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tags) > 0 {
-		for k, _ := range m.Tags {
-			dAtA[i] = 0xa
-			i++
-			v := m.Tags[k]
-			mapSize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + sovInput(uint64(v))
-			i = encodeVarintInput(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintInput(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintInput(dAtA, i, uint64(v))
+	if len(this.Data) != len(that1.Data) {
+		return false
+	}
+	for i := range this.Data {
+		if !bytes.Equal(this.Data[i], that1.Data[i]) {
+			return false
 		}
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	if len(this.Offset) != len(that1.Offset) {
+		return false
 	}
-	if len(m.File) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintInput(dAtA, i, uint64(len(m.File)))
-		i += copy(dAtA[i:], m.File)
+	for i := range this.Offset {
+		if this.Offset[i] != that1.Offset[i] {
+			return false
+		}
 	}
-	return i, nil
+	if this.NextOffset != that1.NextOffset {
+		return false
+	}
+	if this.Generation != that1.Generation {
+		return false
+	}
+	return true
 }
+func (this *CommitInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintInput(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*CommitInput)
+	if !ok {
+		that2, ok := that.(CommitInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Modify) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Pos != 0 {
-		n += 1 + sovInput(uint64(m.Pos))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.Offset != 0 {
-		n += 1 + sovInput(uint64(m.Offset))
+	if this.Group != that1.Group {
+		return false
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if this.Offset != that1.Offset {
+		return false
 	}
-	if m.ResetLength {
-		n += 2
+	if this.Generation != that1.Generation {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Group) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Append) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	that1, ok := that.(*Group)
+	if !ok {
+		that2, ok := that.(Group)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.AllocSize != 0 {
-		n += 1 + sovInput(uint64(m.AllocSize))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovInput(uint64(l))
-		}
+	if this.Name != that1.Name {
+		return false
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if this.Offset != that1.Offset {
+		return false
 	}
-	return n
+	if this.Lag != that1.Lag {
+		return false
+	}
+	return true
 }
+func (this *GroupsOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AppendInput) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AppendPayload) > 0 {
-		for _, e := range m.AppendPayload {
-			l = e.Size()
-			n += 1 + l + sovInput(uint64(l))
+	that1, ok := that.(*GroupsOutput)
+	if !ok {
+		that2, ok := that.(GroupsOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ModifyPayload) > 0 {
-		for _, e := range m.ModifyPayload {
-			l = e.Size()
-			n += 1 + l + sovInput(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return false
 		}
 	}
-	return n
+	return true
 }
+func (this *StatsOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AppendOutput) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Offset) > 0 {
-		l = 0
-		for _, e := range m.Offset {
-			l += sovInput(uint64(e))
+	that1, ok := that.(*StatsOutput)
+	if !ok {
+		that2, ok := that.(StatsOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		n += 1 + sovInput(uint64(l)) + l
 	}
-	if m.ModifiedCount != 0 {
-		n += 1 + sovInput(uint64(m.ModifiedCount))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *NamespaceInput) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if len(this.Tags) != len(that1.Tags) {
+		return false
 	}
-	return n
-}
-
-func (m *SuccessOutput) Size() (n int) {
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *Get) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if this.Offset != that1.Offset {
+		return false
 	}
-	if m.Offset != 0 {
-		n += 1 + sovInput(uint64(m.Offset))
+	if this.File != that1.File {
+		return false
 	}
-	return n
+	return true
 }
+func (this *CountOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetInput) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.GetPayload) > 0 {
-		for _, e := range m.GetPayload {
-			l = e.Size()
-			n += 1 + l + sovInput(uint64(l))
+	that1, ok := that.(*CountOutput)
+	if !ok {
+		that2, ok := that.(CountOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *ScanOutput) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Offset != 0 {
-		n += 1 + sovInput(uint64(m.Offset))
+	if this.Count != that1.Count {
+		return false
 	}
-	return n
-}
-
-func (m *GetOutput) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for _, b := range m.Data {
-			l = len(b)
-			n += 1 + l + sovInput(uint64(l))
+	if len(this.Facets) != len(that1.Facets) {
+		return false
+	}
+	for i := range this.Facets {
+		if this.Facets[i] != that1.Facets[i] {
+			return false
 		}
 	}
-	return n
+	return true
 }
+func (this *TagsInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *StatsOutput) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + sovInput(uint64(v))
-			n += mapEntrySize + 1 + sovInput(uint64(mapEntrySize))
+	that1, ok := that.(*TagsInput)
+	if !ok {
+		that2, ok := that.(TagsInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Offset != 0 {
-		n += 1 + sovInput(uint64(m.Offset))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.File)
-	if l > 0 {
-		n += 1 + l + sovInput(uint64(l))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	return n
+	if this.Prefix != that1.Prefix {
+		return false
+	}
+	if this.Sort != that1.Sort {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
 }
+func (this *Tag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovInput(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*Tag)
+	if !ok {
+		that2, ok := that.(Tag)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozInput(x uint64) (n int) {
-	return sovInput(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Modify) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&Modify{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Pos:` + fmt.Sprintf("%v", this.Pos) + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`ResetLength:` + fmt.Sprintf("%v", this.ResetLength) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Append) String() string {
-	if this == nil {
-		return "nil"
+	if this.Name != that1.Name {
+		return false
 	}
-	s := strings.Join([]string{`&Append{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`AllocSize:` + fmt.Sprintf("%v", this.AllocSize) + `,`,
-		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AppendInput) String() string {
-	if this == nil {
-		return "nil"
+	if this.Count != that1.Count {
+		return false
 	}
-	s := strings.Join([]string{`&AppendInput{`,
-		`AppendPayload:` + strings.Replace(fmt.Sprintf("%v", this.AppendPayload), "Append", "Append", 1) + `,`,
-		`ModifyPayload:` + strings.Replace(fmt.Sprintf("%v", this.ModifyPayload), "Modify", "Modify", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *AppendOutput) String() string {
-	if this == nil {
-		return "nil"
+func (this *TagsOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&AppendOutput{`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`ModifiedCount:` + fmt.Sprintf("%v", this.ModifiedCount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NamespaceInput) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*TagsOutput)
+	if !ok {
+		that2, ok := that.(TagsOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&NamespaceInput{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuccessOutput) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&SuccessOutput{`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Get) String() string {
-	if this == nil {
-		return "nil"
+	if len(this.Tags) != len(that1.Tags) {
+		return false
 	}
-	s := strings.Join([]string{`&Get{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetInput) String() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Tags {
+		if !this.Tags[i].Equal(that1.Tags[i]) {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&GetInput{`,
-		`GetPayload:` + strings.Replace(fmt.Sprintf("%v", this.GetPayload), "Get", "Get", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScanOutput) String() string {
-	if this == nil {
-		return "nil"
+	if this.Total != that1.Total {
+		return false
 	}
-	s := strings.Join([]string{`&ScanOutput{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetOutput) String() string {
-	if this == nil {
-		return "nil"
+	if this.NextFrom != that1.NextFrom {
+		return false
 	}
-	s := strings.Join([]string{`&GetOutput{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *StatsOutput) String() string {
+func (this *VerifyInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerifyInput)
+	if !ok {
+		that2, ok := that.(VerifyInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Repair != that1.Repair {
+		return false
+	}
+	return true
+}
+func (this *VerifyOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerifyOutput)
+	if !ok {
+		that2, ok := that.(VerifyOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Unsorted) != len(that1.Unsorted) {
+		return false
+	}
+	for i := range this.Unsorted {
+		if this.Unsorted[i] != that1.Unsorted[i] {
+			return false
+		}
+	}
+	if this.Repaired != that1.Repaired {
+		return false
+	}
+	return true
+}
+func (this *ExplainNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExplainNode)
+	if !ok {
+		that2, ok := that.(ExplainNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Tag != that1.Tag {
+		return false
+	}
+	if this.Cost != that1.Cost {
+		return false
+	}
+	if this.Next != that1.Next {
+		return false
+	}
+	if this.Advance != that1.Advance {
+		return false
+	}
+	if this.Docs != that1.Docs {
+		return false
+	}
+	if len(this.Children) != len(that1.Children) {
+		return false
+	}
+	for i := range this.Children {
+		if !this.Children[i].Equal(that1.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExplainOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExplainOutput)
+	if !ok {
+		that2, ok := that.(ExplainOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Query.Equal(that1.Query) {
+		return false
+	}
+	if this.Matches != that1.Matches {
+		return false
+	}
+	if this.BytesRead != that1.BytesRead {
+		return false
+	}
+	if this.TookNanoseconds != that1.TookNanoseconds {
+		return false
+	}
+	return true
+}
+func (this *Modify) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForTags := make([]string, 0, len(this.Tags))
-	for k, _ := range this.Tags {
-		keysForTags = append(keysForTags, k)
+	s := make([]string, 0, 12)
+	s = append(s, "&main.Modify{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Pos: "+fmt.Sprintf("%#v", this.Pos)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "ResetLength: "+fmt.Sprintf("%#v", this.ResetLength)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "CheckVersion: "+fmt.Sprintf("%#v", this.CheckVersion)+",\n")
+	s = append(s, "ExpectedVersion: "+fmt.Sprintf("%#v", this.ExpectedVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Append) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	sortkeys.Strings(keysForTags)
-	mapStringForTags := "map[string]uint64{"
-	for _, k := range keysForTags {
-		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
+	s := make([]string, 0, 10)
+	s = append(s, "&main.Append{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "AllocSize: "+fmt.Sprintf("%#v", this.AllocSize)+",\n")
+	s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	keysForFields := make([]string, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
 	}
-	mapStringForTags += "}"
-	s := strings.Join([]string{`&StatsOutput{`,
-		`Tags:` + mapStringForTags + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`File:` + fmt.Sprintf("%v", this.File) + `,`,
-		`}`,
-	}, "")
-	return s
+	sortkeys.Strings(keysForFields)
+	mapStringForFields := "map[string]float64{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%#v: %#v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	if this.Fields != nil {
+		s = append(s, "Fields: "+mapStringForFields+",\n")
+	}
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func valueToStringInput(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *Delete) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.Delete{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AppendInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&main.AppendInput{")
+	if this.AppendPayload != nil {
+		s = append(s, "AppendPayload: "+fmt.Sprintf("%#v", this.AppendPayload)+",\n")
+	}
+	if this.ModifyPayload != nil {
+		s = append(s, "ModifyPayload: "+fmt.Sprintf("%#v", this.ModifyPayload)+",\n")
+	}
+	if this.DeletePayload != nil {
+		s = append(s, "DeletePayload: "+fmt.Sprintf("%#v", this.DeletePayload)+",\n")
+	}
+	s = append(s, "Transactional: "+fmt.Sprintf("%#v", this.Transactional)+",\n")
+	s = append(s, "WithStatus: "+fmt.Sprintf("%#v", this.WithStatus)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ItemStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.ItemStatus{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AppendOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&main.AppendOutput{")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "ModifiedCount: "+fmt.Sprintf("%#v", this.ModifiedCount)+",\n")
+	s = append(s, "DeletedCount: "+fmt.Sprintf("%#v", this.DeletedCount)+",\n")
+	if this.AppendStatus != nil {
+		s = append(s, "AppendStatus: "+fmt.Sprintf("%#v", this.AppendStatus)+",\n")
+	}
+	if this.ModifyStatus != nil {
+		s = append(s, "ModifyStatus: "+fmt.Sprintf("%#v", this.ModifyStatus)+",\n")
+	}
+	if this.DeleteStatus != nil {
+		s = append(s, "DeleteStatus: "+fmt.Sprintf("%#v", this.DeleteStatus)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&main.NamespaceInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&main.CreateInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Durability: "+fmt.Sprintf("%#v", this.Durability)+",\n")
+	s = append(s, "SyncInterval: "+fmt.Sprintf("%#v", this.SyncInterval)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompactInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.CompactInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Online: "+fmt.Sprintf("%#v", this.Online)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuccessOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&main.SuccessOutput{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Get) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&main.Get{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&main.GetInput{")
+	if this.GetPayload != nil {
+		s = append(s, "GetPayload: "+fmt.Sprintf("%#v", this.GetPayload)+",\n")
+	}
+	s = append(s, "SkipCorrupt: "+fmt.Sprintf("%#v", this.SkipCorrupt)+",\n")
+	s = append(s, "WithTime: "+fmt.Sprintf("%#v", this.WithTime)+",\n")
+	s = append(s, "WithVersion: "+fmt.Sprintf("%#v", this.WithVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScanOutput) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := make([]string, 0, 6)
+	s = append(s, "&main.ScanOutput{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&main.GetOutput{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FetchInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&main.FetchInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Group: "+fmt.Sprintf("%#v", this.Group)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "SkipCorrupt: "+fmt.Sprintf("%#v", this.SkipCorrupt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FetchOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&main.FetchOutput{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "NextOffset: "+fmt.Sprintf("%#v", this.NextOffset)+",\n")
+	s = append(s, "Generation: "+fmt.Sprintf("%#v", this.Generation)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CommitInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&main.CommitInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Group: "+fmt.Sprintf("%#v", this.Group)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Generation: "+fmt.Sprintf("%#v", this.Generation)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Group) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&main.Group{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Lag: "+fmt.Sprintf("%#v", this.Lag)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GroupsOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&main.GroupsOutput{")
+	if this.Groups != nil {
+		s = append(s, "Groups: "+fmt.Sprintf("%#v", this.Groups)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StatsOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&main.StatsOutput{")
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k, _ := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]uint64{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%#v: %#v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	if this.Tags != nil {
+		s = append(s, "Tags: "+mapStringForTags+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "File: "+fmt.Sprintf("%#v", this.File)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CountOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.CountOutput{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	keysForFacets := make([]string, 0, len(this.Facets))
+	for k, _ := range this.Facets {
+		keysForFacets = append(keysForFacets, k)
+	}
+	sortkeys.Strings(keysForFacets)
+	mapStringForFacets := "map[string]uint64{"
+	for _, k := range keysForFacets {
+		mapStringForFacets += fmt.Sprintf("%#v: %#v,", k, this.Facets[k])
+	}
+	mapStringForFacets += "}"
+	if this.Facets != nil {
+		s = append(s, "Facets: "+mapStringForFacets+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TagsInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&main.TagsInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Prefix: "+fmt.Sprintf("%#v", this.Prefix)+",\n")
+	s = append(s, "Sort: "+fmt.Sprintf("%#v", this.Sort)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Tag) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.Tag{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TagsOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&main.TagsOutput{")
+	if this.Tags != nil {
+		s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	}
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "NextFrom: "+fmt.Sprintf("%#v", this.NextFrom)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyInput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.VerifyInput{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Repair: "+fmt.Sprintf("%#v", this.Repair)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VerifyOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&main.VerifyOutput{")
+	s = append(s, "Unsorted: "+fmt.Sprintf("%#v", this.Unsorted)+",\n")
+	s = append(s, "Repaired: "+fmt.Sprintf("%#v", this.Repaired)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExplainNode) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&main.ExplainNode{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Tag: "+fmt.Sprintf("%#v", this.Tag)+",\n")
+	s = append(s, "Cost: "+fmt.Sprintf("%#v", this.Cost)+",\n")
+	s = append(s, "Next: "+fmt.Sprintf("%#v", this.Next)+",\n")
+	s = append(s, "Advance: "+fmt.Sprintf("%#v", this.Advance)+",\n")
+	s = append(s, "Docs: "+fmt.Sprintf("%#v", this.Docs)+",\n")
+	if this.Children != nil {
+		s = append(s, "Children: "+fmt.Sprintf("%#v", this.Children)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExplainOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&main.ExplainOutput{")
+	if this.Query != nil {
+		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	}
+	s = append(s, "Matches: "+fmt.Sprintf("%#v", this.Matches)+",\n")
+	s = append(s, "BytesRead: "+fmt.Sprintf("%#v", this.BytesRead)+",\n")
+	s = append(s, "TookNanoseconds: "+fmt.Sprintf("%#v", this.TookNanoseconds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringInput(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Modify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Modify) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Pos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Pos))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.ResetLength {
+		dAtA[i] = 0x28
+		i++
+		if m.ResetLength {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.CheckVersion {
+		dAtA[i] = 0x38
+		i++
+		if m.CheckVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExpectedVersion != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.ExpectedVersion))
+	}
+	return i, nil
+}
+
+func (m *Append) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Append) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.AllocSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.AllocSize))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.Fields) > 0 {
+		for k, _ := range m.Fields {
+			dAtA[i] = 0x32
+			i++
+			v := m.Fields[k]
+			mapSize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + 8
+			i = encodeVarintInput(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x11
+			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i += 8
+		}
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *Delete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delete) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	return i, nil
+}
+
+func (m *AppendInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppendPayload) > 0 {
+		for _, msg := range m.AppendPayload {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ModifyPayload) > 0 {
+		for _, msg := range m.ModifyPayload {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DeletePayload) > 0 {
+		for _, msg := range m.DeletePayload {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Transactional {
+		dAtA[i] = 0x20
+		i++
+		if m.Transactional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.WithStatus {
+		dAtA[i] = 0x28
+		i++
+		if m.WithStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ItemStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *AppendOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offset) > 0 {
+		dAtA2 := make([]byte, len(m.Offset)*10)
+		var j1 int
+		for _, num := range m.Offset {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
+	if m.ModifiedCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.ModifiedCount))
+	}
+	if m.DeletedCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.DeletedCount))
+	}
+	if len(m.AppendStatus) > 0 {
+		for _, msg := range m.AppendStatus {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ModifyStatus) > 0 {
+		for _, msg := range m.ModifyStatus {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DeleteStatus) > 0 {
+		for _, msg := range m.DeleteStatus {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NamespaceInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	return i, nil
+}
+
+func (m *CreateInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Durability) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Durability)))
+		i += copy(dAtA[i:], m.Durability)
+	}
+	if m.SyncInterval != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.SyncInterval))
+	}
+	return i, nil
+}
+
+func (m *CompactInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Online {
+		dAtA[i] = 0x10
+		i++
+		if m.Online {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SuccessOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuccessOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Get) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Get) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *GetInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GetPayload) > 0 {
+		for _, msg := range m.GetPayload {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SkipCorrupt {
+		dAtA[i] = 0x10
+		i++
+		if m.SkipCorrupt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.WithTime {
+		dAtA[i] = 0x18
+		i++
+		if m.WithTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.WithVersion {
+		dAtA[i] = 0x20
+		i++
+		if m.WithVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ScanOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScanOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	return i, nil
+}
+
+func (m *GetOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Time) > 0 {
+		dAtA4 := make([]byte, len(m.Time)*10)
+		var j3 int
+		for _, num1 := range m.Time {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if len(m.Version) > 0 {
+		dAtA6 := make([]byte, len(m.Version)*10)
+		var j5 int
+		for _, num := range m.Version {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	return i, nil
+}
+
+func (m *FetchInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Group) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Limit))
+	}
+	if m.SkipCorrupt {
+		dAtA[i] = 0x20
+		i++
+		if m.SkipCorrupt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *FetchOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Offset) > 0 {
+		dAtA8 := make([]byte, len(m.Offset)*10)
+		var j7 int
+		for _, num := range m.Offset {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
+	if m.NextOffset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.NextOffset))
+	}
+	if m.Generation != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Generation))
+	}
+	return i, nil
+}
+
+func (m *CommitInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Group) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	if m.Generation != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Generation))
+	}
+	return i, nil
+}
+
+func (m *Group) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	if m.Lag != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Lag))
+	}
+	return i, nil
+}
+
+func (m *GroupsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupsOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, msg := range m.Groups {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *StatsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			dAtA[i] = 0xa
+			i++
+			v := m.Tags[k]
+			mapSize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + sovInput(uint64(v))
+			i = encodeVarintInput(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(v))
+		}
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.File) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.File)))
+		i += copy(dAtA[i:], m.File)
+	}
+	return i, nil
+}
+
+func (m *CountOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Facets) > 0 {
+		for k, _ := range m.Facets {
+			dAtA[i] = 0x12
+			i++
+			v := m.Facets[k]
+			mapSize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + sovInput(uint64(v))
+			i = encodeVarintInput(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *TagsInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagsInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if len(m.Sort) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Sort)))
+		i += copy(dAtA[i:], m.Sort)
+	}
+	if m.From != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.From))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *TagsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagsOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Total))
+	}
+	if m.NextFrom != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.NextFrom))
+	}
+	return i, nil
+}
+
+func (m *VerifyInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.Repair {
+		dAtA[i] = 0x10
+		i++
+		if m.Repair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *VerifyOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unsorted) > 0 {
+		for _, s := range m.Unsorted {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Repaired {
+		dAtA[i] = 0x10
+		i++
+		if m.Repaired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ExplainNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Tag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(len(m.Tag)))
+		i += copy(dAtA[i:], m.Tag)
+	}
+	if m.Cost != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Cost))
+	}
+	if m.Next != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Next))
+	}
+	if m.Advance != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Advance))
+	}
+	if m.Docs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Docs))
+	}
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintInput(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExplainOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Query != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Query.Size()))
+		n9, err := m.Query.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Matches != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.Matches))
+	}
+	if m.BytesRead != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.BytesRead))
+	}
+	if m.TookNanoseconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInput(dAtA, i, uint64(m.TookNanoseconds))
+	}
+	return i, nil
+}
+
+func encodeVarintInput(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Modify) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Pos != 0 {
+		n += 1 + sovInput(uint64(m.Pos))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.ResetLength {
+		n += 2
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.CheckVersion {
+		n += 2
+	}
+	if m.ExpectedVersion != 0 {
+		n += 1 + sovInput(uint64(m.ExpectedVersion))
+	}
+	return n
+}
+
+func (m *Append) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.AllocSize != 0 {
+		n += 1 + sovInput(uint64(m.AllocSize))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovInput(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	return n
+}
+
+func (m *Delete) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *AppendInput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AppendPayload) > 0 {
+		for _, e := range m.AppendPayload {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if len(m.ModifyPayload) > 0 {
+		for _, e := range m.ModifyPayload {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if len(m.DeletePayload) > 0 {
+		for _, e := range m.DeletePayload {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if m.Transactional {
+		n += 2
+	}
+	if m.WithStatus {
+		n += 2
+	}
+	return n
+}
+
+func (m *ItemStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovInput(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	return n
+}
+
+func (m *AppendOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Offset) > 0 {
+		l = 0
+		for _, e := range m.Offset {
+			l += sovInput(uint64(e))
+		}
+		n += 1 + sovInput(uint64(l)) + l
+	}
+	if m.ModifiedCount != 0 {
+		n += 1 + sovInput(uint64(m.ModifiedCount))
+	}
+	if m.DeletedCount != 0 {
+		n += 1 + sovInput(uint64(m.DeletedCount))
+	}
+	if len(m.AppendStatus) > 0 {
+		for _, e := range m.AppendStatus {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if len(m.ModifyStatus) > 0 {
+		for _, e := range m.ModifyStatus {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if len(m.DeleteStatus) > 0 {
+		for _, e := range m.DeleteStatus {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	l = len(m.Durability)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.SyncInterval != 0 {
+		n += 1 + sovInput(uint64(m.SyncInterval))
+	}
+	return n
+}
+
+func (m *CompactInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Online {
+		n += 2
+	}
+	return n
+}
+
+func (m *SuccessOutput) Size() (n int) {
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *Get) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	return n
+}
+
+func (m *GetInput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.GetPayload) > 0 {
+		for _, e := range m.GetPayload {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if m.SkipCorrupt {
+		n += 2
+	}
+	if m.WithTime {
+		n += 2
+	}
+	if m.WithVersion {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScanOutput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *GetOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if len(m.Time) > 0 {
+		l = 0
+		for _, e := range m.Time {
+			l += sovInput(uint64(e))
+		}
+		n += 1 + sovInput(uint64(l)) + l
+	}
+	if len(m.Version) > 0 {
+		l = 0
+		for _, e := range m.Version {
+			l += sovInput(uint64(e))
+		}
+		n += 1 + sovInput(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FetchInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovInput(uint64(m.Limit))
+	}
+	if m.SkipCorrupt {
+		n += 2
+	}
+	return n
+}
+
+func (m *FetchOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if len(m.Offset) > 0 {
+		l = 0
+		for _, e := range m.Offset {
+			l += sovInput(uint64(e))
+		}
+		n += 1 + sovInput(uint64(l)) + l
+	}
+	if m.NextOffset != 0 {
+		n += 1 + sovInput(uint64(m.NextOffset))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovInput(uint64(m.Generation))
+	}
+	return n
+}
+
+func (m *CommitInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovInput(uint64(m.Generation))
+	}
+	return n
+}
+
+func (m *Group) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovInput(uint64(m.Lag))
+	}
+	return n
+}
+
+func (m *GroupsOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StatsOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + sovInput(uint64(v))
+			n += mapEntrySize + 1 + sovInput(uint64(mapEntrySize))
+		}
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInput(uint64(m.Offset))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	return n
+}
+
+func (m *CountOutput) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovInput(uint64(m.Count))
+	}
+	if len(m.Facets) > 0 {
+		for k, v := range m.Facets {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovInput(uint64(len(k))) + 1 + sovInput(uint64(v))
+			n += mapEntrySize + 1 + sovInput(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *TagsInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	l = len(m.Sort)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovInput(uint64(m.From))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovInput(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovInput(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *TagsOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovInput(uint64(m.Total))
+	}
+	if m.NextFrom != 0 {
+		n += 1 + sovInput(uint64(m.NextFrom))
+	}
+	return n
+}
+
+func (m *VerifyInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Repair {
+		n += 2
+	}
+	return n
+}
+
+func (m *VerifyOutput) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Unsorted) > 0 {
+		for _, s := range m.Unsorted {
+			l = len(s)
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	if m.Repaired {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExplainNode) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovInput(uint64(m.Cost))
+	}
+	if m.Next != 0 {
+		n += 1 + sovInput(uint64(m.Next))
+	}
+	if m.Advance != 0 {
+		n += 1 + sovInput(uint64(m.Advance))
+	}
+	if m.Docs != 0 {
+		n += 1 + sovInput(uint64(m.Docs))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovInput(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExplainOutput) Size() (n int) {
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovInput(uint64(l))
+	}
+	if m.Matches != 0 {
+		n += 1 + sovInput(uint64(m.Matches))
+	}
+	if m.BytesRead != 0 {
+		n += 1 + sovInput(uint64(m.BytesRead))
+	}
+	if m.TookNanoseconds != 0 {
+		n += 1 + sovInput(uint64(m.TookNanoseconds))
+	}
+	return n
+}
+
+func sovInput(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozInput(x uint64) (n int) {
+	return sovInput(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Modify) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Modify{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Pos:` + fmt.Sprintf("%v", this.Pos) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`ResetLength:` + fmt.Sprintf("%v", this.ResetLength) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`CheckVersion:` + fmt.Sprintf("%v", this.CheckVersion) + `,`,
+		`ExpectedVersion:` + fmt.Sprintf("%v", this.ExpectedVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Append) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]string, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	sortkeys.Strings(keysForFields)
+	mapStringForFields := "map[string]float64{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	s := strings.Join([]string{`&Append{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`AllocSize:` + fmt.Sprintf("%v", this.AllocSize) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Delete) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Delete{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppendInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppendInput{`,
+		`AppendPayload:` + strings.Replace(fmt.Sprintf("%v", this.AppendPayload), "Append", "Append", 1) + `,`,
+		`ModifyPayload:` + strings.Replace(fmt.Sprintf("%v", this.ModifyPayload), "Modify", "Modify", 1) + `,`,
+		`DeletePayload:` + strings.Replace(fmt.Sprintf("%v", this.DeletePayload), "Delete", "Delete", 1) + `,`,
+		`Transactional:` + fmt.Sprintf("%v", this.Transactional) + `,`,
+		`WithStatus:` + fmt.Sprintf("%v", this.WithStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ItemStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ItemStatus{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppendOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppendOutput{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`ModifiedCount:` + fmt.Sprintf("%v", this.ModifiedCount) + `,`,
+		`DeletedCount:` + fmt.Sprintf("%v", this.DeletedCount) + `,`,
+		`AppendStatus:` + strings.Replace(fmt.Sprintf("%v", this.AppendStatus), "ItemStatus", "ItemStatus", 1) + `,`,
+		`ModifyStatus:` + strings.Replace(fmt.Sprintf("%v", this.ModifyStatus), "ItemStatus", "ItemStatus", 1) + `,`,
+		`DeleteStatus:` + strings.Replace(fmt.Sprintf("%v", this.DeleteStatus), "ItemStatus", "ItemStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Durability:` + fmt.Sprintf("%v", this.Durability) + `,`,
+		`SyncInterval:` + fmt.Sprintf("%v", this.SyncInterval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompactInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompactInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Online:` + fmt.Sprintf("%v", this.Online) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuccessOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuccessOutput{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Get) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Get{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetInput{`,
+		`GetPayload:` + strings.Replace(fmt.Sprintf("%v", this.GetPayload), "Get", "Get", 1) + `,`,
+		`SkipCorrupt:` + fmt.Sprintf("%v", this.SkipCorrupt) + `,`,
+		`WithTime:` + fmt.Sprintf("%v", this.WithTime) + `,`,
+		`WithVersion:` + fmt.Sprintf("%v", this.WithVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScanOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScanOutput{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetOutput{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FetchInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FetchInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`SkipCorrupt:` + fmt.Sprintf("%v", this.SkipCorrupt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FetchOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FetchOutput{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`NextOffset:` + fmt.Sprintf("%v", this.NextOffset) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CommitInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CommitInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Group) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Group{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Lag:` + fmt.Sprintf("%v", this.Lag) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupsOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupsOutput{`,
+		`Groups:` + strings.Replace(fmt.Sprintf("%v", this.Groups), "Group", "Group", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k, _ := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]uint64{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	s := strings.Join([]string{`&StatsOutput{`,
+		`Tags:` + mapStringForTags + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`File:` + fmt.Sprintf("%v", this.File) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CountOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFacets := make([]string, 0, len(this.Facets))
+	for k, _ := range this.Facets {
+		keysForFacets = append(keysForFacets, k)
+	}
+	sortkeys.Strings(keysForFacets)
+	mapStringForFacets := "map[string]uint64{"
+	for _, k := range keysForFacets {
+		mapStringForFacets += fmt.Sprintf("%v: %v,", k, this.Facets[k])
+	}
+	mapStringForFacets += "}"
+	s := strings.Join([]string{`&CountOutput{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Facets:` + mapStringForFacets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TagsInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TagsInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Sort:` + fmt.Sprintf("%v", this.Sort) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Tag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Tag{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TagsOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TagsOutput{`,
+		`Tags:` + strings.Replace(fmt.Sprintf("%v", this.Tags), "Tag", "Tag", 1) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`NextFrom:` + fmt.Sprintf("%v", this.NextFrom) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifyInput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyInput{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Repair:` + fmt.Sprintf("%v", this.Repair) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifyOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyOutput{`,
+		`Unsorted:` + fmt.Sprintf("%v", this.Unsorted) + `,`,
+		`Repaired:` + fmt.Sprintf("%v", this.Repaired) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExplainNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExplainNode{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Cost:` + fmt.Sprintf("%v", this.Cost) + `,`,
+		`Next:` + fmt.Sprintf("%v", this.Next) + `,`,
+		`Advance:` + fmt.Sprintf("%v", this.Advance) + `,`,
+		`Docs:` + fmt.Sprintf("%v", this.Docs) + `,`,
+		`Children:` + strings.Replace(fmt.Sprintf("%v", this.Children), "ExplainNode", "ExplainNode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExplainOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExplainOutput{`,
+		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "ExplainNode", "ExplainNode", 1) + `,`,
+		`Matches:` + fmt.Sprintf("%v", this.Matches) + `,`,
+		`BytesRead:` + fmt.Sprintf("%v", this.BytesRead) + `,`,
+		`TookNanoseconds:` + fmt.Sprintf("%v", this.TookNanoseconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringInput(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Modify) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Modify: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Modify: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			m.Pos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pos |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetLength", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetLength = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckVersion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckVersion = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVersion", wireType)
+			}
+			m.ExpectedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVersion |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Append) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Append: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Append: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocSize", wireType)
+			}
+			m.AllocSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthInput
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipInput(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthInput
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fields[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppendPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppendPayload = append(m.AppendPayload, &Append{})
+			if err := m.AppendPayload[len(m.AppendPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModifyPayload = append(m.ModifyPayload, &Modify{})
+			if err := m.ModifyPayload[len(m.ModifyPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletePayload = append(m.DeletePayload, &Delete{})
+			if err := m.DeletePayload[len(m.DeletePayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transactional = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithStatus = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItemStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Offset = append(m.Offset, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInput
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Offset = append(m.Offset, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedCount", wireType)
+			}
+			m.ModifiedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModifiedCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedCount", wireType)
+			}
+			m.DeletedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppendStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppendStatus = append(m.AppendStatus, &ItemStatus{})
+			if err := m.AppendStatus[len(m.AppendStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModifyStatus = append(m.ModifyStatus, &ItemStatus{})
+			if err := m.ModifyStatus[len(m.ModifyStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteStatus = append(m.DeleteStatus, &ItemStatus{})
+			if err := m.DeleteStatus[len(m.DeleteStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Durability = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncInterval", wireType)
+			}
+			m.SyncInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncInterval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Online = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuccessOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuccessOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuccessOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Get) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Get: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Get: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GetPayload = append(m.GetPayload, &Get{})
+			if err := m.GetPayload[len(m.GetPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCorrupt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCorrupt = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithTime = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithVersion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithVersion = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScanOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScanOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScanOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Time = append(m.Time, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInput
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Time = append(m.Time, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Version = append(m.Version, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInput
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Version = append(m.Version, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCorrupt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCorrupt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Modify) Unmarshal(dAtA []byte) error {
+func (m *FetchOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1563,17 +6894,17 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Modify: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Modify: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1583,26 +6914,88 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Offset = append(m.Offset, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInput
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Offset = append(m.Offset, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOffset", wireType)
 			}
-			m.Pos = 0
+			m.NextOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1612,16 +7005,16 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Pos |= (int32(b) & 0x7F) << shift
+				m.NextOffset |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
-			m.Offset = 0
+			m.Generation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1631,16 +7024,66 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (uint64(b) & 0x7F) << shift
+				m.Generation |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1650,28 +7093,55 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1681,12 +7151,30 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ResetLength = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -1708,7 +7196,7 @@ func (m *Modify) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Append) Unmarshal(dAtA []byte) error {
+func (m *Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,15 +7219,15 @@ func (m *Append) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Append: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Append: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,13 +7252,13 @@ func (m *Append) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.AllocSize = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1780,16 +7268,16 @@ func (m *Append) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AllocSize |= (uint32(b) & 0x7F) << shift
+				m.Offset |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
 			}
-			var stringLen uint64
+			m.Lag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1799,26 +7287,66 @@ func (m *Append) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Lag |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupsOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInput
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupsOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1828,21 +7356,21 @@ func (m *Append) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Groups = append(m.Groups, &Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1866,7 +7394,7 @@ func (m *Append) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendInput) Unmarshal(dAtA []byte) error {
+func (m *StatsOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1889,15 +7417,15 @@ func (m *AppendInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppendPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1921,16 +7449,111 @@ func (m *AppendInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppendPayload = append(m.AppendPayload, &Append{})
-			if err := m.AppendPayload[len(m.AppendPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Tags == nil {
+				m.Tags = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInput
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthInput
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipInput(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthInput
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifyPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -1940,22 +7563,20 @@ func (m *AppendInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModifyPayload = append(m.ModifyPayload, &Modify{})
-			if err := m.ModifyPayload[len(m.ModifyPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.File = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1978,7 +7599,7 @@ func (m *AppendInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendOutput) Unmarshal(dAtA []byte) error {
+func (m *CountOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2001,32 +7622,65 @@ func (m *AppendOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInput
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
 				}
-				m.Offset = append(m.Offset, v)
-			} else if wireType == 2 {
-				var packedLen int
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Facets == nil {
+				m.Facets = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowInput
@@ -2036,20 +7690,39 @@ func (m *AppendOutput) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					wire |= (uint64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInput
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInput
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthInput
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowInput
@@ -2059,35 +7732,28 @@ func (m *AppendOutput) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
+						mapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Offset = append(m.Offset, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedCount", wireType)
-			}
-			m.ModifiedCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInput
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ModifiedCount |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipInput(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthInput
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Facets[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -2109,7 +7775,7 @@ func (m *AppendOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceInput) Unmarshal(dAtA []byte) error {
+func (m *TagsInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,10 +7798,10 @@ func (m *NamespaceInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: TagsInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TagsInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2167,6 +7833,102 @@ func (m *NamespaceInput) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -2188,7 +7950,7 @@ func (m *NamespaceInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuccessOutput) Unmarshal(dAtA []byte) error {
+func (m *Tag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2211,17 +7973,46 @@ func (m *SuccessOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuccessOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuccessOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2231,12 +8022,11 @@ func (m *SuccessOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Count |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -2258,7 +8048,7 @@ func (m *SuccessOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Get) Unmarshal(dAtA []byte) error {
+func (m *TagsOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2281,17 +8071,17 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Get: wiretype end group for non-group")
+			return fmt.Errorf("proto: TagsOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Get: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TagsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2301,26 +8091,28 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, &Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.Offset = 0
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2330,7 +8122,26 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (uint64(b) & 0x7F) << shift
+				m.Total |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFrom", wireType)
+			}
+			m.NextFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFrom |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2356,7 +8167,7 @@ func (m *Get) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetInput) Unmarshal(dAtA []byte) error {
+func (m *VerifyInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2379,17 +8190,17 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2399,23 +8210,41 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GetPayload = append(m.GetPayload, &Get{})
-			if err := m.GetPayload[len(m.GetPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repair = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -2437,7 +8266,7 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanOutput) Unmarshal(dAtA []byte) error {
+func (m *VerifyOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2460,17 +8289,17 @@ func (m *ScanOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsorted", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2480,28 +8309,26 @@ func (m *ScanOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Unsorted = append(m.Unsorted, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaired", wireType)
 			}
-			m.Offset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2511,11 +8338,12 @@ func (m *ScanOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Repaired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -2537,7 +8365,7 @@ func (m *ScanOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOutput) Unmarshal(dAtA []byte) error {
+func (m *ExplainNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,17 +8388,17 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExplainNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExplainNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2580,20 +8408,156 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInput
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
-			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advance", wireType)
+			}
+			m.Advance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Advance |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Docs", wireType)
+			}
+			m.Docs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Docs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &ExplainNode{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2616,7 +8580,7 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsOutput) Unmarshal(dAtA []byte) error {
+func (m *ExplainOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2639,15 +8603,15 @@ func (m *StatsOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExplainOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExplainOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2671,92 +8635,18 @@ func (m *StatsOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = make(map[string]uint64)
+			if m.Query == nil {
+				m.Query = &ExplainNode{}
 			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInput
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInput
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthInput
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInput
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipInput(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthInput
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
 			}
-			m.Offset = 0
+			m.Matches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2766,16 +8656,16 @@ func (m *StatsOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (uint64(b) & 0x7F) << shift
+				m.Matches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
 			}
-			var stringLen uint64
+			m.BytesRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInput
@@ -2785,21 +8675,30 @@ func (m *StatsOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInput
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TookNanoseconds", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TookNanoseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TookNanoseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.File = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInput(dAtA[iNdEx:])
@@ -2929,38 +8828,89 @@ var (
 func init() { proto.RegisterFile("input.proto", fileDescriptorInput) }
 
 var fileDescriptorInput = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xcd, 0xc6, 0x4e, 0x88, 0x27, 0x09, 0x42, 0x2b, 0x84, 0x2c, 0x38, 0x2d, 0x96, 0x45, 0xe1,
-	0x6b, 0x8c, 0x74, 0x08, 0x71, 0x82, 0x8a, 0x43, 0x28, 0x42, 0x3a, 0xe0, 0xb4, 0xe1, 0x07, 0x16,
-	0x67, 0x93, 0xb3, 0x70, 0xbc, 0x96, 0xbd, 0x46, 0x0a, 0x15, 0x15, 0x35, 0xff, 0x40, 0xc3, 0xa7,
-	0x50, 0x5e, 0x49, 0x49, 0x4c, 0x43, 0x79, 0x9f, 0x80, 0x76, 0xd7, 0x8e, 0x1d, 0xa1, 0xe3, 0xba,
-	0x99, 0xd9, 0x99, 0x79, 0x6f, 0xde, 0xce, 0xc0, 0x38, 0x4e, 0xb3, 0x52, 0x86, 0x59, 0x2e, 0xa4,
-	0xc0, 0xf6, 0x9a, 0xc5, 0xa9, 0xff, 0x05, 0xc1, 0xf0, 0xb5, 0x58, 0xc4, 0xcb, 0x0d, 0x3e, 0x00,
-	0x27, 0x65, 0x6b, 0x5e, 0x64, 0x2c, 0xe2, 0x2e, 0xf2, 0x50, 0xe0, 0xd0, 0x36, 0x80, 0x6f, 0x81,
-	0x95, 0x89, 0xc2, 0xed, 0x7b, 0x28, 0x18, 0x50, 0x65, 0xe2, 0x3b, 0x30, 0x14, 0xcb, 0x65, 0xc1,
-	0xa5, 0x6b, 0x79, 0x28, 0xb0, 0x69, 0xed, 0x61, 0x0c, 0xf6, 0x82, 0x49, 0xe6, 0xda, 0x1e, 0x0a,
-	0x26, 0x54, 0xdb, 0xd8, 0x83, 0x71, 0xce, 0x0b, 0x2e, 0x4f, 0x79, 0xba, 0x92, 0xe7, 0xee, 0xc0,
-	0x43, 0xc1, 0x88, 0x76, 0x43, 0x7e, 0x02, 0xc3, 0xe7, 0x59, 0xc6, 0xd3, 0xc5, 0x35, 0x3c, 0x0e,
-	0xc0, 0x61, 0x49, 0x22, 0xa2, 0x79, 0xfc, 0x89, 0x6b, 0x36, 0x53, 0xda, 0x06, 0x14, 0xb6, 0x64,
-	0xab, 0xc2, 0xb5, 0x3d, 0x2b, 0x70, 0xa8, 0xb6, 0x77, 0x7c, 0x06, 0x2d, 0x1f, 0xbf, 0x84, 0xb1,
-	0x41, 0x7b, 0xa5, 0x14, 0xc1, 0x47, 0x30, 0x65, 0xda, 0x3d, 0x63, 0x9b, 0x44, 0xb0, 0x85, 0x8b,
-	0x3c, 0x2b, 0x18, 0x1f, 0x4d, 0x42, 0xa5, 0x51, 0x68, 0x32, 0xe9, 0x7e, 0x8a, 0xaa, 0x59, 0x6b,
-	0xe1, 0x9a, 0x9a, 0x7e, 0xb7, 0xc6, 0x68, 0x4a, 0xf7, 0x53, 0xfc, 0x53, 0x98, 0x98, 0x66, 0x6f,
-	0x4b, 0xa9, 0x70, 0x5b, 0x09, 0x15, 0x60, 0x2b, 0xe1, 0x83, 0xba, 0x77, 0xcc, 0x17, 0x2f, 0x44,
-	0x99, 0x4a, 0x3d, 0xa8, 0x4d, 0xf7, 0x83, 0x7e, 0x08, 0x37, 0xdf, 0x34, 0xba, 0x98, 0x39, 0xfe,
-	0x2b, 0x9d, 0x7f, 0x08, 0xd3, 0x79, 0x19, 0x45, 0xbc, 0x28, 0x6a, 0x78, 0x17, 0x6e, 0x14, 0x26,
-	0xa0, 0x93, 0x47, 0xb4, 0x71, 0xfd, 0x67, 0x60, 0xcd, 0xf8, 0x35, 0xfd, 0x3a, 0xec, 0xfb, 0xdd,
-	0x05, 0xf0, 0x1f, 0xc3, 0x68, 0xc6, 0xa5, 0x61, 0x74, 0x08, 0xb0, 0xe2, 0x72, 0x5f, 0x56, 0xc7,
-	0x48, 0x34, 0xe3, 0x92, 0x76, 0x1e, 0xfd, 0x63, 0x80, 0x79, 0xc4, 0xd2, 0x9a, 0x5b, 0xf3, 0x6b,
-	0xa8, 0xb3, 0x45, 0x57, 0x01, 0xde, 0x07, 0x67, 0xc6, 0xe5, 0x3f, 0x85, 0xd6, 0xee, 0xbb, 0xbf,
-	0x21, 0x18, 0xcf, 0x25, 0x93, 0xcd, 0xe0, 0x0f, 0xeb, 0x35, 0x31, 0x7c, 0xee, 0x19, 0x3e, 0x9d,
-	0x84, 0xf0, 0x1d, 0x5b, 0x15, 0x2f, 0x53, 0x99, 0x6f, 0xea, 0x1d, 0xba, 0x02, 0x59, 0x81, 0x2d,
-	0xe3, 0x84, 0xeb, 0x0b, 0x70, 0xa8, 0xb6, 0xef, 0x3e, 0x01, 0x67, 0x57, 0xae, 0xce, 0xe6, 0x03,
-	0xdf, 0xd4, 0xda, 0x29, 0x13, 0xdf, 0x86, 0xc1, 0x47, 0x96, 0x94, 0xbc, 0xee, 0x64, 0x9c, 0xa7,
-	0xfd, 0x63, 0x74, 0x32, 0xbb, 0xd8, 0x92, 0xde, 0xcf, 0x2d, 0xe9, 0x5d, 0x6e, 0x09, 0xfa, 0x5c,
-	0x11, 0xf4, 0xbd, 0x22, 0xe8, 0x47, 0x45, 0xd0, 0x45, 0x45, 0xd0, 0xaf, 0x8a, 0xa0, 0x3f, 0x15,
-	0xe9, 0x5d, 0x56, 0x04, 0x7d, 0xfd, 0x4d, 0x7a, 0x80, 0xd3, 0x24, 0xcc, 0xf2, 0xcd, 0x3a, 0x0f,
-	0x73, 0x11, 0x9d, 0xf3, 0xa5, 0xc8, 0xe5, 0xc9, 0xe0, 0x4c, 0x9d, 0xf6, 0xfb, 0xa1, 0xbe, 0xf0,
-	0x47, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xaf, 0xb0, 0x4c, 0xf0, 0x03, 0x00, 0x00,
+	// 1329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0xde, 0x5e, 0x8f, 0x1d, 0xbb, 0x6c, 0x43, 0x18, 0xad, 0x90, 0xb5, 0x24, 0x23, 0x6b, 0x88,
+	0x84, 0x73, 0xc0, 0x41, 0x09, 0x3f, 0x81, 0x03, 0x12, 0xd9, 0x64, 0x57, 0x91, 0xc8, 0x8f, 0x7a,
+	0x57, 0xb9, 0x71, 0xe8, 0xcc, 0xb4, 0xed, 0xd1, 0x8e, 0xa7, 0x87, 0x9e, 0x9e, 0x65, 0xcd, 0x05,
+	0x1e, 0x01, 0x21, 0xe5, 0xc2, 0x95, 0x0b, 0x8f, 0xc0, 0x23, 0x70, 0xcc, 0x91, 0x23, 0x31, 0x12,
+	0x42, 0xe2, 0x40, 0x1e, 0x01, 0x55, 0x77, 0xcf, 0xb8, 0x9d, 0x64, 0x7f, 0xc8, 0xad, 0xaa, 0xba,
+	0x6a, 0xaa, 0xea, 0xab, 0x9f, 0xee, 0x81, 0x6e, 0x92, 0xe5, 0xa5, 0x1a, 0xe7, 0x52, 0x28, 0xe1,
+	0x7b, 0x73, 0x96, 0x64, 0xe1, 0x5f, 0x04, 0x5a, 0xf7, 0x44, 0x9c, 0x4c, 0x16, 0xfe, 0x25, 0xe8,
+	0x64, 0x6c, 0xce, 0x8b, 0x9c, 0x45, 0x7c, 0x40, 0x86, 0x64, 0xd4, 0xa1, 0x2b, 0x81, 0x7f, 0x11,
+	0x1a, 0xb9, 0x28, 0x06, 0x9b, 0x43, 0x32, 0x6a, 0x52, 0x24, 0xfd, 0xb7, 0xa1, 0x25, 0x26, 0x93,
+	0x82, 0xab, 0x41, 0x63, 0x48, 0x46, 0x1e, 0xb5, 0x9c, 0xef, 0x83, 0x17, 0x33, 0xc5, 0x06, 0xde,
+	0x90, 0x8c, 0x7a, 0x54, 0xd3, 0xfe, 0x10, 0xba, 0x92, 0x17, 0x5c, 0x7d, 0xc9, 0xb3, 0xa9, 0x9a,
+	0x0d, 0x9a, 0x43, 0x32, 0x6a, 0x53, 0x57, 0x84, 0xdf, 0x3f, 0xe4, 0x8b, 0x41, 0x4b, 0xfb, 0x45,
+	0xd2, 0x0f, 0xa1, 0x17, 0xcd, 0x78, 0x74, 0xf8, 0x88, 0xcb, 0x22, 0x11, 0xd9, 0xe0, 0x82, 0x36,
+	0x5a, 0x93, 0xf9, 0x23, 0x78, 0x93, 0x1f, 0xe7, 0x3c, 0x52, 0x3c, 0xae, 0xd4, 0xda, 0x43, 0x32,
+	0xea, 0xd3, 0x17, 0xc5, 0xe1, 0x3f, 0x04, 0x5a, 0x5f, 0xe4, 0x39, 0xcf, 0xe2, 0x33, 0x12, 0xbd,
+	0x04, 0x1d, 0x96, 0xa6, 0x22, 0xda, 0x4f, 0xbe, 0xe5, 0x3a, 0xdd, 0x3e, 0x5d, 0x09, 0x30, 0x39,
+	0xc5, 0xa6, 0xc5, 0xc0, 0x1b, 0x36, 0x46, 0x1d, 0xaa, 0xe9, 0x3a, 0xe1, 0xa6, 0x93, 0xf0, 0x07,
+	0xd0, 0x9a, 0x24, 0x3c, 0x8d, 0x8b, 0x41, 0x6b, 0xd8, 0x18, 0x75, 0xaf, 0x0f, 0xc6, 0x08, 0xf7,
+	0xd8, 0x44, 0x30, 0xde, 0xd5, 0x47, 0x77, 0x32, 0x25, 0x17, 0xd4, 0xea, 0x55, 0x00, 0x5c, 0xa8,
+	0x01, 0xd8, 0xfe, 0x14, 0xba, 0x8e, 0x62, 0xa5, 0x40, 0x56, 0x08, 0x6d, 0x41, 0xf3, 0x88, 0xa5,
+	0xa5, 0x09, 0x93, 0x50, 0xc3, 0x7c, 0xb6, 0x79, 0x93, 0x84, 0x9f, 0x43, 0xeb, 0x36, 0x4f, 0xb9,
+	0xe2, 0x67, 0x24, 0xbb, 0xaa, 0xe1, 0xa6, 0x5b, 0xc3, 0xf0, 0x5f, 0x02, 0x5d, 0x13, 0xeb, 0x5d,
+	0x6c, 0x19, 0xff, 0x3a, 0xf4, 0x99, 0x66, 0x1f, 0xb2, 0x45, 0x2a, 0x58, 0x3c, 0x20, 0x3a, 0xab,
+	0x9e, 0x9b, 0x15, 0x5d, 0x57, 0x41, 0x9b, 0xb9, 0xee, 0xac, 0xca, 0x66, 0xd3, 0xb5, 0x31, 0x4d,
+	0x47, 0xd7, 0x55, 0xd0, 0x26, 0xd6, 0x71, 0x57, 0x36, 0x0d, 0xd7, 0xc6, 0xa4, 0x44, 0xd7, 0x55,
+	0xfc, 0x2b, 0xd0, 0x57, 0x92, 0x65, 0x05, 0x8b, 0x54, 0x22, 0x32, 0x96, 0xea, 0xc6, 0x6b, 0xd3,
+	0x75, 0xa1, 0x1f, 0x00, 0x7c, 0x93, 0xa8, 0xd9, 0xbe, 0x62, 0xaa, 0x2c, 0x6c, 0x03, 0x3a, 0x92,
+	0xf0, 0x63, 0x80, 0xbb, 0x8a, 0xcf, 0x0d, 0x87, 0x25, 0x8d, 0x44, 0x6c, 0x00, 0xeb, 0x53, 0x4d,
+	0x23, 0xda, 0x5c, 0x4a, 0x21, 0x35, 0x54, 0x1d, 0x6a, 0x98, 0xf0, 0xc7, 0x4d, 0xe8, 0x99, 0xfc,
+	0x1f, 0x94, 0x0a, 0xa1, 0x5a, 0x41, 0x8a, 0x18, 0xad, 0xc6, 0xe2, 0x8a, 0x85, 0x23, 0xe1, 0xf1,
+	0x8e, 0x28, 0xb3, 0x0a, 0xf1, 0x75, 0x21, 0x36, 0xbd, 0xc9, 0xce, 0x2a, 0x99, 0xd1, 0x5a, 0x93,
+	0xf9, 0x1f, 0x42, 0xcf, 0x20, 0x6d, 0x93, 0xf1, 0x34, 0x46, 0x17, 0x0d, 0x46, 0xab, 0x24, 0xe8,
+	0x9a, 0x16, 0x5a, 0x19, 0xac, 0x6b, 0x08, 0x4e, 0xb0, 0x72, 0xb5, 0xd0, 0xca, 0xf8, 0xb6, 0x56,
+	0xad, 0x93, 0xac, 0x5c, 0xad, 0x70, 0x0c, 0x6f, 0xdc, 0xaf, 0x7a, 0xcc, 0x34, 0xd0, 0xa9, 0x6d,
+	0x18, 0x0a, 0xe8, 0xee, 0x48, 0xce, 0xd4, 0x79, 0x94, 0xb1, 0x92, 0x71, 0x29, 0xd9, 0xe3, 0x24,
+	0x4d, 0xd4, 0xc2, 0x16, 0xc3, 0x91, 0x20, 0x84, 0xc5, 0x22, 0x8b, 0xee, 0x66, 0x8a, 0xcb, 0x23,
+	0x96, 0x6a, 0x08, 0xfb, 0x74, 0x4d, 0x16, 0xde, 0x86, 0xde, 0x8e, 0x98, 0xe7, 0x2c, 0x52, 0xe7,
+	0xf1, 0x88, 0x25, 0xcd, 0xd2, 0x24, 0x33, 0x83, 0xd6, 0xa6, 0x96, 0x0b, 0xaf, 0x42, 0x7f, 0xbf,
+	0x8c, 0x22, 0x5e, 0x14, 0xb6, 0xf6, 0x03, 0xb8, 0x50, 0x18, 0x81, 0xfe, 0x48, 0x9b, 0x56, 0x6c,
+	0x78, 0x0f, 0x1a, 0x7b, 0x5c, 0xbd, 0xde, 0x34, 0x56, 0x93, 0xdf, 0xa8, 0x27, 0x3f, 0x7c, 0x42,
+	0xa0, 0xbd, 0xc7, 0x6d, 0xf0, 0x57, 0x01, 0xa6, 0x5c, 0xad, 0x4f, 0x66, 0xc7, 0x54, 0x68, 0x8f,
+	0x2b, 0xea, 0x1c, 0xe2, 0x1e, 0x2e, 0x0e, 0x93, 0x7c, 0x47, 0x48, 0x59, 0xe6, 0xca, 0xa6, 0xe3,
+	0x8a, 0xfc, 0x6d, 0x68, 0xe3, 0x54, 0x1c, 0x24, 0x73, 0xae, 0x1d, 0xb6, 0x69, 0xcd, 0xa3, 0x35,
+	0xd2, 0xd5, 0xa6, 0x35, 0x73, 0xe6, 0x8a, 0xc2, 0x9b, 0x00, 0xfb, 0x11, 0xcb, 0x2c, 0x1c, 0xd5,
+	0x62, 0x24, 0xce, 0x62, 0x3c, 0x69, 0xe3, 0xdc, 0x83, 0xce, 0x1e, 0x57, 0x2f, 0x19, 0x36, 0x6a,
+	0x43, 0xdc, 0xbc, 0x18, 0x14, 0x6e, 0x91, 0x06, 0xd5, 0x34, 0xe2, 0x7d, 0x64, 0x83, 0xc1, 0x45,
+	0xd1, 0xa7, 0x15, 0x1b, 0x1e, 0x03, 0xec, 0x72, 0x15, 0xcd, 0xce, 0x53, 0xde, 0x2d, 0x68, 0x4e,
+	0xa5, 0x28, 0xf3, 0x6a, 0xb0, 0x35, 0x83, 0xd2, 0x34, 0x99, 0x27, 0xca, 0xf6, 0x8f, 0x61, 0x5e,
+	0x04, 0xd0, 0x7b, 0x09, 0xc0, 0x70, 0x01, 0x5d, 0xed, 0xf9, 0x94, 0x54, 0x5c, 0x0c, 0xdc, 0x15,
+	0x11, 0x00, 0x64, 0xfc, 0x58, 0x3d, 0x70, 0x6f, 0x55, 0x47, 0x82, 0xe7, 0x53, 0x9e, 0x71, 0xc9,
+	0x54, 0x05, 0xbf, 0x47, 0x1d, 0x09, 0xba, 0xde, 0x11, 0xf3, 0x79, 0xa2, 0x5e, 0x3f, 0xeb, 0x93,
+	0x2e, 0xf5, 0xb3, 0x5c, 0xdf, 0x81, 0xe6, 0x9e, 0xfe, 0x80, 0x0f, 0x1e, 0xfa, 0xb0, 0xfe, 0x34,
+	0x7d, 0x5a, 0x5f, 0xa7, 0x6c, 0x6a, 0x3d, 0x21, 0x19, 0xde, 0x80, 0x9e, 0xfe, 0x4c, 0x35, 0x50,
+	0xef, 0x42, 0x4b, 0xc7, 0x55, 0xd8, 0xb6, 0xee, 0xda, 0xb6, 0x46, 0x19, 0xb5, 0x47, 0xe1, 0xcf,
+	0x04, 0xba, 0xb8, 0x78, 0x2a, 0xa3, 0x6b, 0xf6, 0x8e, 0x36, 0x26, 0xef, 0x18, 0x13, 0x47, 0x61,
+	0x7c, 0xc0, 0xa6, 0xf6, 0xf2, 0xd5, 0x8a, 0x27, 0xc6, 0xe7, 0x83, 0x37, 0x49, 0x52, 0x6e, 0x07,
+	0x4f, 0xd3, 0xdb, 0x9f, 0x40, 0xa7, 0x36, 0x3f, 0xeb, 0x4a, 0xf6, 0xdc, 0x2b, 0xf9, 0x09, 0xc1,
+	0xea, 0x94, 0x59, 0xd5, 0xe3, 0x5b, 0xd0, 0x8c, 0x90, 0xd5, 0xd6, 0x1e, 0x35, 0x8c, 0xff, 0x11,
+	0xb4, 0x26, 0x2c, 0xe2, 0xaa, 0xb0, 0xb7, 0xe5, 0x65, 0x13, 0xbd, 0x63, 0x38, 0xde, 0xd5, 0xe7,
+	0xd5, 0xe3, 0x41, 0x33, 0xfa, 0xa9, 0xb0, 0x12, 0xff, 0xaf, 0xb8, 0xbe, 0x33, 0x09, 0x9d, 0x73,
+	0x0f, 0xe6, 0x92, 0x4f, 0x92, 0x63, 0xdb, 0x33, 0x96, 0x43, 0x9c, 0x0a, 0x21, 0x55, 0x85, 0x13,
+	0xd2, 0x1a, 0x3b, 0x29, 0xe6, 0xba, 0x55, 0xfa, 0x54, 0xd3, 0xab, 0x91, 0x6a, 0x3a, 0x23, 0x15,
+	0x5e, 0x83, 0xc6, 0x01, 0x9b, 0xbe, 0xb2, 0x71, 0x6a, 0x8c, 0x36, 0x1d, 0x8c, 0xc2, 0xaf, 0x00,
+	0x30, 0x62, 0x8b, 0xe3, 0xe5, 0xb5, 0x6a, 0xdb, 0xbd, 0x77, 0xc0, 0xa6, 0xb6, 0xb6, 0x5b, 0xd0,
+	0x54, 0x42, 0xb1, 0xb4, 0xfa, 0x84, 0x66, 0x70, 0xcb, 0xe1, 0x5c, 0xed, 0x62, 0x84, 0x66, 0xbe,
+	0x6b, 0x3e, 0xdc, 0x81, 0xee, 0x23, 0x2e, 0x93, 0xc9, 0xe2, 0x9c, 0x90, 0x48, 0x9e, 0xb3, 0x44,
+	0x56, 0x57, 0x83, 0xe1, 0xc2, 0x5d, 0xe8, 0x99, 0x8f, 0xd8, 0x28, 0xb7, 0xa1, 0x5d, 0x66, 0x08,
+	0x0c, 0x37, 0x1b, 0xba, 0x43, 0x6b, 0x1e, 0xcf, 0x8c, 0x15, 0x8f, 0xed, 0x57, 0x6a, 0x3e, 0xfc,
+	0x95, 0x40, 0xf7, 0xce, 0x71, 0x9e, 0xb2, 0x24, 0xbb, 0x8f, 0x8f, 0x10, 0xdc, 0x82, 0x8b, 0xbc,
+	0x46, 0x09, 0x69, 0xac, 0xb6, 0x62, 0x53, 0x5b, 0x13, 0x24, 0xcd, 0xf3, 0xa5, 0x30, 0x05, 0x69,
+	0x50, 0x4d, 0x6b, 0x7c, 0xf9, 0xb1, 0xb2, 0xb3, 0xab, 0x69, 0xdc, 0x9f, 0x2c, 0x3e, 0x62, 0x59,
+	0xc4, 0x75, 0x49, 0x3c, 0x5a, 0xb1, 0x7a, 0x6d, 0x89, 0xa8, 0xd0, 0xef, 0x71, 0x8f, 0x6a, 0xda,
+	0x7f, 0x1f, 0xda, 0xd1, 0x2c, 0x49, 0x63, 0xc9, 0xf1, 0x31, 0x8e, 0x68, 0xbf, 0x65, 0xd0, 0x76,
+	0x02, 0xa4, 0xb5, 0x4a, 0xf8, 0x13, 0x81, 0xbe, 0x3d, 0xb1, 0x20, 0xbc, 0x07, 0xcd, 0xaf, 0x4b,
+	0x2e, 0x4d, 0x63, 0xbe, 0xd2, 0xda, 0x9c, 0x63, 0x5c, 0x73, 0xa6, 0xa2, 0x19, 0x2f, 0x6c, 0xd9,
+	0x2a, 0x16, 0xab, 0xf1, 0x78, 0xa1, 0x78, 0x41, 0xb9, 0x7e, 0x1c, 0xe2, 0xd9, 0x4a, 0x80, 0xbf,
+	0x03, 0x4a, 0x88, 0xc3, 0xfb, 0x2c, 0x13, 0x05, 0x8f, 0x44, 0x16, 0x17, 0x3a, 0xdd, 0x06, 0x7d,
+	0x51, 0x7c, 0x6b, 0xef, 0xe9, 0xb3, 0x60, 0xe3, 0xf7, 0x67, 0xc1, 0xc6, 0xf3, 0x67, 0x01, 0xf9,
+	0x7e, 0x19, 0x90, 0x5f, 0x96, 0x01, 0xf9, 0x6d, 0x19, 0x90, 0xa7, 0xcb, 0x80, 0xfc, 0xb1, 0x0c,
+	0xc8, 0xdf, 0xcb, 0x60, 0xe3, 0xf9, 0x32, 0x20, 0x3f, 0xfc, 0x19, 0x6c, 0x80, 0x9f, 0xa5, 0xe3,
+	0x5c, 0x2e, 0xe6, 0x72, 0x2c, 0x45, 0x34, 0xe3, 0x13, 0x21, 0xd5, 0xad, 0xe6, 0x43, 0xfc, 0x8d,
+	0x7a, 0xdc, 0xd2, 0x7f, 0x53, 0x37, 0xfe, 0x1b, 0x00, 0xcb, 0x83, 0x08, 0xf2, 0x5c, 0x0d, 0x00,
+	0x00,
 }
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// append.keys maps the keys of the records to their latest offset, it
+// is a log of [key length 2 bytes][offset 8 bytes][crc 4 bytes][key]
+// entries replayed in a hash map when the namespace is opened; the
+// biggest offset of a key wins, so the order of the entries does not
+// matter, and the log is rewritten with only the latest entries when
+// the namespace is compacted
+
+const keyEntryHeaderLen = 2 + 8 + 4
+const maxKeyLen = 0xffff
+
+var keyNotFoundError = errors.New("key not found")
+
+type KeyIndex struct {
+	descriptor *os.File
+	path       string
+	offsets    map[string]uint64
+	size       int64
+	dirty      uint32
+	sync.Mutex
+}
+
+func encodeKeyEntry(key string, offset uint64) []byte {
+	data := make([]byte, keyEntryHeaderLen+len(key))
+	binary.LittleEndian.PutUint16(data, uint16(len(key)))
+	binary.LittleEndian.PutUint64(data[2:], offset)
+	copy(data[keyEntryHeaderLen:], key)
+	binary.LittleEndian.PutUint32(data[10:], crc(append(data[:10:10], data[keyEntryHeaderLen:]...)))
+	return data
+}
+
+// replays the log, entries pointing after end are dropped, and a torn
+// entry left by a crash is truncated
+func openKeyIndex(indexPath string, end uint64) (*KeyIndex, error) {
+	k := &KeyIndex{path: indexPath}
+	err := k.load(end)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (this *KeyIndex) load(end uint64) error {
+	f, size := openAtEnd(this.path)
+	this.descriptor = f
+	this.offsets = map[string]uint64{}
+
+	data, err := readAll(f, int64(size))
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	dropped := 0
+	valid := 0
+	for valid+keyEntryHeaderLen <= len(data) {
+		keyLen := int(binary.LittleEndian.Uint16(data[valid:]))
+		next := valid + keyEntryHeaderLen + keyLen
+		if next > len(data) {
+			break
+		}
+		entry := data[valid:next]
+		if binary.LittleEndian.Uint32(entry[10:]) != crc(append(entry[:10:10], entry[keyEntryHeaderLen:]...)) {
+			break
+		}
+
+		key := string(entry[keyEntryHeaderLen:])
+		offset := binary.LittleEndian.Uint64(entry[2:])
+		if offset >= end {
+			dropped++
+		} else if current, ok := this.offsets[key]; !ok || offset > current {
+			this.offsets[key] = offset
+		}
+		valid = next
+	}
+	this.size = int64(valid)
+
+	if valid < len(data) {
+		log.Printf("%s recovery: truncating torn entry from %d to %d", this.path, len(data), valid)
+		err = f.Truncate(int64(valid))
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if dropped > 0 {
+		log.Printf("%s recovery: dropped %d entries pointing after %d", this.path, dropped, end)
+		err = this.rewrite()
+		if err != nil {
+			this.descriptor.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKey(key string) error {
+	if len(key) > maxKeyLen {
+		return &invalidInputError{fmt.Sprintf("key is longer than %d bytes", maxKeyLen)}
+	}
+	return nil
+}
+
+func (this *KeyIndex) put(key string, offset uint64) {
+	this.Lock()
+	defer this.Unlock()
+
+	data := encodeKeyEntry(key, offset)
+	_, err := this.descriptor.WriteAt(data, this.size)
+	if err != nil {
+		panic(err)
+	}
+	this.size += int64(len(data))
+	atomic.StoreUint32(&this.dirty, 1)
+
+	if current, ok := this.offsets[key]; !ok || offset > current {
+		this.offsets[key] = offset
+	}
+}
+
+func (this *KeyIndex) get(key string) (uint64, bool) {
+	this.Lock()
+	defer this.Unlock()
+
+	offset, ok := this.offsets[key]
+	return offset, ok
+}
+
+// writes only the latest entries, must be called with the lock held
+func (this *KeyIndex) rewrite() error {
+	data := encodeKeys(this.offsets)
+
+	tmpPath := this.path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteAt(data, 0)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, this.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	this.descriptor.Close()
+	this.descriptor = f
+	this.size = int64(len(data))
+	return nil
+}
+
+func encodeKeys(offsets map[string]uint64) []byte {
+	data := []byte{}
+	for key, offset := range offsets {
+		data = append(data, encodeKeyEntry(key, offset)...)
+	}
+	return data
+}
+
+// writes the keys relocated with the relocation map produced by
+// compact() next to append.keys, it is renamed over it by
+// swapCompacted(); keys whose latest record did not survive the
+// compaction are dropped
+func (this *KeyIndex) stageRelocation(relocationMap map[uint64]uint64) ([]string, error) {
+	this.Lock()
+	relocated := make(map[string]uint64, len(this.offsets))
+	for key, offset := range this.offsets {
+		if newOffset, ok := relocationMap[offset]; ok {
+			relocated[key] = newOffset
+		}
+	}
+	this.Unlock()
+
+	return []string{this.path}, stageFile(this.path, encodeKeys(relocated))
+}
+
+// replays append.keys again after it was replaced by swapCompacted()
+func (this *KeyIndex) reopen(end uint64) error {
+	this.Lock()
+	defer this.Unlock()
+
+	this.descriptor.Close()
+	return this.load(end)
+}
+
+func (this *KeyIndex) sync() error {
+	this.Lock()
+	defer this.Unlock()
+	return this.descriptor.Sync()
+}
+
+func (this *KeyIndex) close() {
+	this.Lock()
+	defer this.Unlock()
+	this.descriptor.Close()
+}
+
+// reads the latest record appended with the key, if a compaction moved
+// it in between the key is looked up again
+func (this *StoreItem) readKey(key string) ([]byte, *Header, error) {
+	for {
+		generation := atomic.LoadUint64(&this.generation)
+		offset, ok := this.keys.get(key)
+		if !ok {
+			return nil, nil, keyNotFoundError
+		}
+		data, header, err := this.readRecord(offset)
+		if atomic.LoadUint64(&this.generation) == generation {
+			return data, header, err
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKeys(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_keys_test")
+	os.RemoveAll(root)
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+
+	get := func(key string) string {
+		data, _, err := storage.readKey(key)
+		if err != nil {
+			return err.Error()
+		}
+		return string(data)
+	}
+	expect := func(expected map[string]string) {
+		for key, value := range expected {
+			if got := get(key); got != value {
+				t.Logf("key %s: expected %s, got %s", key, value, got)
+				t.FailNow()
+			}
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		storage.appendItem(&Append{Data: []byte(fmt.Sprintf("a%d", i)), AllocSize: 8, Key: "a"})
+		storage.appendItem(&Append{Data: []byte(fmt.Sprintf("b%d", i)), Key: fmt.Sprintf("b%d", i%2)})
+	}
+	expect(map[string]string{"a": "a9", "b0": "b8", "b1": "b9", "c": keyNotFoundError.Error()})
+
+	err := storage.modifyItem(&Modify{Key: "a", Pos: -1, Data: []byte("x")})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	err = storage.modifyItem(&Modify{Key: "c", Data: []byte("x")})
+	if err != keyNotFoundError {
+		t.Logf("expected key not found, got %v", err)
+		t.FailNow()
+	}
+	expect(map[string]string{"a": "a9x"})
+
+	// the latest record of b1 is deleted, so it is gone after compaction
+	offset, _ := storage.keys.get("b1")
+	storage.deleteRecord(offset)
+	_, err = storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	expect(map[string]string{"a": "a9x", "b0": "b8", "b1": keyNotFoundError.Error()})
+
+	// reopened with an entry pointing after the end and a torn entry
+	end := storage.offset
+	storage.keys.put("d", end)
+	storage.keys.descriptor.WriteAt(encodeKeyEntry("e", 0)[:5], storage.keys.size)
+	storage.descriptor.Close()
+	storage.keys.close()
+	storage = NewStorage(root, Durability{})
+	defer storage.close()
+	expect(map[string]string{"a": "a9x", "b0": "b8", "d": keyNotFoundError.Error(), "e": keyNotFoundError.Error()})
+	fi, _ := storage.keys.descriptor.Stat()
+	if fi.Size() != storage.keys.size || len(storage.keys.offsets) != 2 {
+		t.Logf("expected the keys to be rewritten, size %d, keys %v", fi.Size(), storage.keys.offsets)
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
+
+func TestReadKeyDuringCompaction(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_keys_compaction_test")
+	os.RemoveAll(root)
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+
+	for i := 0; i < 20; i++ {
+		storage.appendItem(&Append{Data: []byte(fmt.Sprintf("k%d-", i)), AllocSize: 16, Key: fmt.Sprintf("k%d", i)})
+	}
+
+	// does not wait for the compaction
+	storage.compaction.Lock()
+	read := make(chan error)
+	go func() {
+		_, _, err := storage.readKey("k1")
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+	case <-time.After(5 * time.Second):
+		t.Log("readKey waited for the compaction")
+		t.FailNow()
+	}
+	storage.compaction.Unlock()
+
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for n := 0; n < 50; n++ {
+			i := n % 20
+			storage.appendItem(&Append{Data: []byte(fmt.Sprintf("k%d-%d", i, n)), AllocSize: 16, Key: fmt.Sprintf("k%d", i)})
+			_, err := storage.compactOnline()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		for i := 0; i < 20; i++ {
+			data, _, err := storage.readKey(fmt.Sprintf("k%d", i))
+			if err != nil || !strings.HasPrefix(string(data), fmt.Sprintf("k%d-", i)) {
+				t.Logf("key k%d: got %s %v", i, data, err)
+				t.FailNow()
+			}
+		}
+	}
+	os.RemoveAll(root)
+}
@@ -15,6 +15,8 @@ import (
 	"os/signal"
 	"path"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -22,33 +24,48 @@ import (
 	"time"
 )
 
-type PostingsList struct {
-	descriptor *os.File
-	offset     uint64
-}
-
-func (this *PostingsList) newTermQuery() *Term {
-	postings := make([]byte, this.offset)
-	n, err := this.descriptor.ReadAt(postings, 0)
-	if n != len(postings) && err != nil {
-		postings = []byte{}
-	}
-
-	longed := make([]int64, len(postings)/8)
-	j := 0
-	for i := 0; i < len(postings); i += 8 {
-		longed[j] = int64(binary.LittleEndian.Uint64(postings[i:]))
-		j++
-	}
-	return NewTerm(longed)
-}
-
 type StoreItem struct {
 	path       string
 	root       string
 	descriptor *os.File
 	index      map[string]*PostingsList
+	fields     map[string]*NumericIndex
+	keys       *KeyIndex
 	offset     uint64
+	compaction sync.RWMutex
+
+	durability Durability
+	stopSyncer chan bool
+	writes     uint64
+	synced     uint64
+	syncLock   sync.Mutex
+
+	// incremented when compaction changes the offsets
+	generation uint64
+	appended   chan bool
+	closing    chan bool
+	tailLock   sync.Mutex
+
+	// committed offsets of the consumer groups
+	groups     map[string]uint64
+	groupsLock sync.Mutex
+
+	// tagged appends write their postings in the order they reserved
+	// their offsets, so the postings lists stay sorted
+	postingsLock    sync.Mutex
+	postingsTurn    *sync.Cond
+	postingsTicket  uint64
+	postingsWritten uint64
+
+	// modify and delete read the header before writing it
+	modifyLock sync.Mutex
+
+	// recovery found data but no valid header, compaction would drop
+	// all of it
+	unrecognized bool
+
+	times *timeIndex
+
 	sync.RWMutex
 }
 
@@ -73,30 +90,65 @@ func openAtEnd(filePath string) (*os.File, uint64) {
 	return f, uint64(offset)
 }
 
-func NewStorage(root string) *StoreItem {
+func NewStorage(root string, durability Durability) *StoreItem {
 	os.MkdirAll(root, 0700)
+	err := recoverCompaction(root)
+	if err != nil {
+		panic(err)
+	}
 
 	filePath := path.Join(root, "append.raw")
 	f, offset := openAtEnd(filePath)
 
 	si := &StoreItem{
-		offset:     uint64(offset),
 		path:       filePath,
 		index:      map[string]*PostingsList{},
+		fields:     map[string]*NumericIndex{},
 		descriptor: f,
 		root:       root,
+		closing:    make(chan bool),
+		groups:     map[string]uint64{},
+		times:      &timeIndex{},
+		generation: loadGeneration(root),
+	}
+	si.postingsTurn = sync.NewCond(&si.postingsLock)
+	si.offset = si.recover(offset)
+
+	keys, err := openKeyIndex(path.Join(root, "append.keys"), si.offset)
+	if err != nil {
+		panic(err)
 	}
+	si.keys = keys
 
 	files, err := ioutil.ReadDir(root)
 	if err != nil {
 		panic(err)
 	}
 	for _, dirFile := range files {
+		if isLeftover(dirFile.Name()) {
+			log.Printf("%s recovery: removing leftover %s", root, dirFile.Name())
+			os.Remove(path.Join(root, dirFile.Name()))
+			continue
+		}
 		if strings.HasSuffix(dirFile.Name(), ".postings") {
 			dot := strings.IndexRune(dirFile.Name(), '.')
 			idxName := dirFile.Name()[:dot]
-			si.CreatePostingsList(idxName)
+			si.CreatePostingsList(idxName).recover(si.offset)
+		}
+		if strings.HasSuffix(dirFile.Name(), ".numeric") {
+			si.CreateNumericIndex(strings.TrimSuffix(dirFile.Name(), ".numeric")).recover(si.offset)
 		}
+		if strings.HasSuffix(dirFile.Name(), ".group") {
+			si.loadGroup(strings.TrimSuffix(dirFile.Name(), ".group"))
+		}
+	}
+
+	if d, ok := loadDurability(root); ok {
+		durability = d
+	}
+	err = si.setDurability(durability, false)
+	if err != nil {
+		log.Printf("%s invalid durability, using none, err: %s", root, err.Error())
 	}
 
 	return si
@@ -113,6 +165,26 @@ func (this *StoreItem) GetPostingsList(name string) *PostingsList {
 	return nil
 }
 
+// returns the postings lists of the tags accepted by match, sorted by
+// tag name
+func (this *StoreItem) MatchingPostingsLists(match func(string) bool) []*PostingsList {
+	this.RLock()
+	names := []string{}
+	for name := range this.index {
+		if match(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	out := make([]*PostingsList, len(names))
+	for i, name := range names {
+		out[i] = this.index[name]
+	}
+	this.RUnlock()
+	return out
+}
+
 func (this *StoreItem) CreatePostingsList(name string) *PostingsList {
 	name = sanitize(name)
 	this.RLock()
@@ -129,10 +201,10 @@ func (this *StoreItem) CreatePostingsList(name string) *PostingsList {
 		return p
 	}
 
-	f, offset := openAtEnd(path.Join(this.root, fmt.Sprintf("%s.postings", name)))
-	p := &PostingsList{
-		descriptor: f,
-		offset:     offset,
+	postingsPath := path.Join(this.root, fmt.Sprintf("%s.postings", name))
+	p, err := openPostingsList(postingsPath)
+	if err != nil {
+		panic(err)
 	}
 	this.index[name] = p
 	return p
@@ -148,316 +220,1085 @@ func (this *StoreItem) stats() *StatsOutput {
 	this.RLock()
 	defer this.RUnlock()
 	for name, index := range this.index {
-		out.Tags[name] = index.offset / 8
+		out.Tags[name] = index.size()
 	}
 
 	return out
 }
 
-func (this *StoreItem) scan(cb func(uint64, []byte) bool) {
-SCAN:
-	for offset := uint64(0); offset < this.offset; {
-		// this is lockless, which means we could read a header,
-		// but the data might be incomplete
+// checks that all postings lists are sorted, and rewrites the unsorted
+// ones with repair
+func (this *StoreItem) verifyPostings(repair bool) (*VerifyOutput, error) {
+	// appends hold the read lock while writing postings
+	this.Lock()
+	defer this.Unlock()
+
+	names := make([]string, 0, len(this.index))
+	for name := range this.index {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-		dataLen, allocSize, err := readHeader(this.descriptor, offset)
+	out := &VerifyOutput{Repaired: repair}
+	for _, name := range names {
+		unsorted, err := this.index[name].verify(repair)
 		if err != nil {
-			break SCAN
+			return nil, err
 		}
-		output := make([]byte, dataLen)
-		_, err = this.descriptor.ReadAt(output, int64(offset)+int64(headerLen))
-		if err != nil {
-			break SCAN
+		if unsorted {
+			out.Unsorted = append(out.Unsorted, name)
+		}
+	}
+	return out, nil
+}
+
+const (
+	tagsSortByName = "name"
+	tagsSortBySize = "size"
+)
+
+// lists the tags starting with prefix, sorted by name, or by number of
+// postings (biggest first), the page starts at position from
+func (this *StoreItem) listTags(prefix string, sortBy string, from int, limit int) (*TagsOutput, error) {
+	if sortBy == "" {
+		sortBy = tagsSortByName
+	}
+	if sortBy != tagsSortByName && sortBy != tagsSortBySize {
+		return nil, fmt.Errorf("[sort] must be %s or %s", tagsSortByName, tagsSortBySize)
+	}
+	prefix = sanitize(prefix)
+
+	tags := []*Tag{}
+	this.RLock()
+	for name, index := range this.index {
+		if strings.HasPrefix(name, prefix) {
+			tags = append(tags, &Tag{Name: name, Count: index.size()})
+		}
+	}
+	this.RUnlock()
+
+	sort.Slice(tags, func(i, j int) bool {
+		if sortBy == tagsSortBySize && tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Name < tags[j].Name
+	})
+
+	out := &TagsOutput{Total: uint64(len(tags))}
+	if from > len(tags) {
+		from = len(tags)
+	}
+	to := from + limit
+	if to > len(tags) {
+		to = len(tags)
+	}
+	out.Tags = tags[from:to]
+	out.NextFrom = uint32(to)
+	return out, nil
+}
+
+type ScanOptions struct {
+	skipCorrupt bool
+	// offset range, records starting in [from, to) are scanned, 0
+	// means no limit for to
+	from uint64
+	to   uint64
+	// stop after that many records, 0 means no limit
+	limit int
+	// write time range in nanoseconds, 0 means no limit
+	fromTime int64
+	toTime   int64
+}
+
+// returns the offset where the next scan should continue from
+func (this *StoreItem) scan(options ScanOptions, cb func(uint64, *Header, []byte) bool) (uint64, error) {
+	offset := options.from
+	if options.fromTime > 0 {
+		byTime := this.findOffsetByTime(options.fromTime)
+		if byTime > offset {
+			offset = byTime
+		}
+	}
+	end := this.completedOffset()
+	if offset > 0 {
+		// the offset might be in the middle of a record, for example
+		// when a file is scanned in parallel by splitting it in ranges
+		offset = this.findNextRecord(offset, end)
+	}
+
+	count := 0
+	for offset < end {
+		if options.to > 0 && offset >= options.to {
+			break
+		}
+		if options.limit > 0 && count >= options.limit {
+			break
+		}
+
+		// if compaction swaps the file while scanning we will most
+		// likely hit an invalid header and stop
+		output, header, err := this.readRecord(offset)
+		if header != nil && options.toTime > 0 && header.time > options.toTime {
+			break
 		}
 
-		if !cb(offset, output) {
-			break SCAN
+		switch err {
+		case nil:
+			if header.time >= options.fromTime {
+				if !cb(offset, header, output) {
+					return offset, nil
+				}
+				count++
+			}
+		case deletedError:
+			// deleted records are hidden
+		case wrongValueChecksumError:
+			if !options.skipCorrupt {
+				return offset, fmt.Errorf("%s at offset %d", err.Error(), offset)
+			}
+		default:
+			return offset, nil
 		}
 
-		offset += uint64(allocSize) + uint64(headerLen)
+		offset += uint64(header.allocSize) + header.size()
 	}
+	return offset, nil
 }
 
-func (this *StoreItem) compact() (map[uint64]uint64, error) {
+// append() holds the read lock until the record is written, so all the
+// records before the offset observed under the write lock are complete
+func (this *StoreItem) completedOffset() uint64 {
 	this.Lock()
 	defer this.Unlock()
+	return this.offset
+}
 
-	relocationMap := map[uint64]uint64{}
+func (this *StoreItem) findNextRecord(offset, end uint64) uint64 {
+	this.RLock()
+	defer this.RUnlock()
 
-	if len(this.index) > 0 {
-		return nil, errors.New("can not compact indexed items")
+	start, _, err := gotoNextValidHeader(this.descriptor, offset, end)
+	if err != nil {
+		return end
 	}
-	if this.offset <= headerLen {
-		return nil, errors.New("data is too small, nothing to compact")
+	return start
+}
+
+// the records are appended in time order (concurrent appends can be
+// slightly out of order), so we can start from the last record of the
+// time index written before t and walk the headers to the first record
+// written at or after t
+func (this *StoreItem) findOffsetByTime(t int64) uint64 {
+	this.RLock()
+	defer this.RUnlock()
+
+	end := atomic.LoadUint64(&this.offset)
+	offset := this.times.before(t)
+	for offset < end {
+		header, err := readHeader(this.descriptor, offset)
+		if err != nil {
+			// corrupted, or not written yet
+			offset, header, err = gotoNextValidHeader(this.descriptor, offset, end)
+			if err != nil {
+				return end
+			}
+		}
+		if header.time >= t {
+			return offset
+		}
+		offset += header.size() + uint64(header.allocSize)
 	}
+	return end
+}
 
-	endOffet := this.offset - uint64(headerLen)
-	needCompaction := false
-	for offset := uint64(0); offset < endOffet; {
+func (this *StoreItem) needsCompaction(endOffset uint64) bool {
+	for offset := uint64(0); offset < endOffset; {
 		// this is lockless, which means we could read a header,
 		// but the data might be incomplete
-		uncorruptedOffset, dataLen, allocSize, err := gotoNextValidHeader(this.descriptor, offset, endOffet)
+		uncorruptedOffset, header, err := gotoNextValidHeader(this.descriptor, offset, endOffset)
 		if err != nil {
-			needCompaction = true
-			break
+			return true
 		}
 		if offset != uncorruptedOffset {
-			needCompaction = true
-			break
+			return true
 		}
-		if dataLen != allocSize {
-			needCompaction = true
-			break
+		if header.dataLen != header.allocSize || header.flags&flagDeleted != 0 || header.legacy {
+			return true
 		}
-		offset += uint64(allocSize) + uint64(headerLen)
-	}
-
-	if !needCompaction {
-		log.Printf("%s no compaction needed", this.root)
-		return nil, nil
+		offset += uint64(header.allocSize) + header.size()
 	}
+	return false
+}
 
-	actualOffset := uint64(0)
-	for offset := uint64(0); offset < endOffet; {
-		// this is lockless, which means we could read a header,
-		// but the data might be incomplete
-		uncorruptedOffset, dataLen, allocSize, err := gotoNextValidHeader(this.descriptor, offset, endOffet)
+// copies the records between offset and endOffset from append.raw
+// into the compacted file, starting at actualOffset in the compacted
+// file, and adds them to the time index of the compacted file, returns
+// where it stopped in both files
+func (this *StoreItem) copyRecords(compacted *os.File, offset, endOffset, actualOffset uint64, relocationMap map[uint64]uint64, times *timeIndex) (uint64, uint64) {
+	for offset < endOffset {
+		uncorruptedOffset, header, err := gotoNextValidHeader(this.descriptor, offset, endOffset)
 		if err != nil {
 			log.Printf("%s failed to read header at %d, err: %s", this.root, offset, err.Error())
-			break
+			return endOffset, actualOffset
 		}
 		if offset != uncorruptedOffset {
-			log.Printf("%s found corrupt header, skipped from %d to %d dataLen: %d, allocSize: %d, end: %d", this.root, offset, uncorruptedOffset, dataLen, allocSize, endOffet)
+			log.Printf("%s found corrupt header, skipped from %d to %d dataLen: %d, allocSize: %d, end: %d", this.root, offset, uncorruptedOffset, header.dataLen, header.allocSize, endOffset)
 		}
 		offset = uncorruptedOffset
+		dataLen := header.dataLen
+		allocSize := header.allocSize
+
+		if header.flags&flagDeleted != 0 {
+			// not in the relocation map, so its postings are dropped
+			offset += uint64(allocSize) + header.size()
+			continue
+		}
 
 		storedData := make([]byte, dataLen)
-		_, err = this.descriptor.ReadAt(storedData, int64(offset)+int64(headerLen))
+		_, err = this.descriptor.ReadAt(storedData, int64(offset+header.size()))
 		if err != nil {
-			log.Printf("%s failed to read data at %d, err: %s", this.root, offset+headerLen, err.Error())
-			break
+			log.Printf("%s failed to read data at %d, err: %s", this.root, offset+header.size(), err.Error())
+			return endOffset, actualOffset
 		}
 
-		this.writeHeader(actualOffset, dataLen, dataLen)
+		// keeps the write time and the value checksum, records written
+		// before 3.0 are upgraded to the current header
+		recordHeaderLen := header.size()
+		if header.legacy {
+			header.legacy = false
+			header.valueChecksum = crc(storedData)
+			header.flags = flagValueChecksum
+		}
+		header.allocSize = dataLen
+		writeHeader(compacted, actualOffset, header)
 		relocationMap[offset] = actualOffset
+		times.add(header.time, actualOffset)
 
-		_, err = this.descriptor.WriteAt(storedData, int64(actualOffset)+int64(headerLen))
+		_, err = compacted.WriteAt(storedData, int64(actualOffset)+int64(headerLen))
 		if err != nil {
 			log.Printf("%s failed to write data at %d, err: %s", this.root, int64(actualOffset)+int64(headerLen), err.Error())
-			break
+			return endOffset, actualOffset
 		}
 		actualOffset += uint64(dataLen) + uint64(headerLen)
-		offset += uint64(allocSize) + uint64(headerLen)
-	}
-
-	// this will lose data if something was actually written in the end of the file
-	// we will also truncate it
-	// make sure you are compacting data you are no longer writing to
-	err := this.descriptor.Truncate(int64(actualOffset))
-	if err != nil {
-		log.Fatalf("failed to truncate file to %d, err: %s", actualOffset, err.Error())
+		offset += uint64(allocSize) + recordHeaderLen
 	}
-
-	log.Printf("compaction %s done, old size: %d, new size: %d", this.root, this.offset, actualOffset)
-	atomic.StoreUint64(&this.offset, actualOffset)
-
-	return relocationMap, nil
+	return offset, actualOffset
 }
 
-func (this *StoreItem) ExecuteQuery(query Query, cb func(uint64, []byte) bool) {
-	for query.Next() != NO_MORE {
-		offset := uint64(query.GetDocId())
-		dataLen, _, err := readHeader(this.descriptor, offset)
+// replaces append.raw with the compacted file and relocates the
+// postings lists, the numeric fields, the keys and the consumer groups,
+// must be called with the write lock held; see compaction.go for how a
+// crash in the middle is handled
+func (this *StoreItem) swapCompacted(compacted *os.File, actualOffset uint64, relocationMap map[uint64]uint64, times *timeIndex) error {
+	this.groupsLock.Lock()
+	defer this.groupsLock.Unlock()
+
+	staged := []string{this.path}
+	err := compacted.Sync()
+	for _, p := range this.index {
 		if err != nil {
 			break
 		}
-
-		output := make([]byte, dataLen)
-		_, err = this.descriptor.ReadAt(output, int64(offset)+int64(headerLen))
+		var files []string
+		files, err = p.stageRelocation(relocationMap)
+		staged = append(staged, files...)
+	}
+	for _, n := range this.fields {
 		if err != nil {
 			break
 		}
-
-		if !cb(offset, output) {
-			break
-		}
+		var files []string
+		files, err = n.stageRelocation(relocationMap)
+		staged = append(staged, files...)
+	}
+	if err == nil {
+		var files []string
+		files, err = this.keys.stageRelocation(relocationMap)
+		staged = append(staged, files...)
+	}
+	var groups map[string]uint64
+	if err == nil {
+		var files []string
+		groups, files, err = this.stageGroups(relocationMap, actualOffset)
+		staged = append(staged, files...)
+	}
+	generation := atomic.LoadUint64(&this.generation) + 1
+	if err == nil {
+		staged = append(staged, generationPath(this.root))
+		err = stageGeneration(this.root, generation)
+	}
+	if err == nil {
+		err = writeCompactionMarker(this.root, staged)
+	}
+	if err != nil {
+		compacted.Close()
+		removeStaged(staged)
+		return err
 	}
-}
 
-const headerLen = 4 + 8 + 4 + 4
+	// from here on recovery finishes the swap if we crash
+	err = finishCompaction(this.root, staged)
+	if err != nil {
+		log.Fatalf("%s failed to swap the compacted files, they are swapped when it is opened again, err: %s", this.root, err.Error())
+	}
+	this.descriptor.Close()
+	this.descriptor = compacted
+	this.times = times
 
-var wrongChecksumError = errors.New("wrong checksum")
-var noValidHeaderFoundError = errors.New("no valid header found")
+	log.Printf("compaction %s done, old size: %d, new size: %d", this.root, this.offset, actualOffset)
+	atomic.StoreUint64(&this.offset, actualOffset)
 
-func gotoNextValidHeader(file *os.File, offset, endOffset uint64) (uint64, uint32, uint32, error) {
-	for start := offset; start < endOffset; start++ {
-		dataLen, allocSize, err := readHeader(file, start)
-		if err == nil {
-			return start, dataLen, allocSize, nil
-		} else {
-			if err == io.EOF {
-				return 0, 0, 0, noValidHeaderFoundError
-			}
+	for name, p := range this.index {
+		err := p.reopen()
+		if err != nil {
+			log.Fatalf("failed to reopen %s/%s.postings, err: %s", this.root, name, err.Error())
 		}
 	}
-
-	return 0, 0, 0, noValidHeaderFoundError
-
-}
-func readHeader(file *os.File, offset uint64) (uint32, uint32, error) {
-	headerBytes := make([]byte, headerLen)
-	_, err := file.ReadAt(headerBytes, int64(offset))
+	for name, n := range this.fields {
+		err := n.reopen()
+		if err != nil {
+			log.Fatalf("failed to reopen %s/%s.numeric, err: %s", this.root, name, err.Error())
+		}
+	}
+	err = this.keys.reopen(actualOffset)
 	if err != nil {
-		return 0, 0, err
+		log.Fatalf("failed to reopen %s, err: %s", this.keys.path, err.Error())
 	}
-	dataLen := binary.LittleEndian.Uint32(headerBytes[0:])
+	this.groups = groups
 
-	allocSize := binary.LittleEndian.Uint32(headerBytes[12:])
-	checksum := binary.LittleEndian.Uint32(headerBytes[16:])
-	computedChecksum := crc(headerBytes[0:16])
-	if checksum != computedChecksum {
-		return 0, 0, wrongChecksumError
-	}
-	return dataLen, allocSize, nil
+	// after the indexes are reopened, so a reader that got an offset
+	// from them with the same generation can trust it
+	atomic.StoreUint64(&this.generation, generation)
+	this.notifyTailers()
+	return nil
 }
 
-func (this *StoreItem) writeHeader(currentOffset uint64, dataLen uint32, allocSize uint32) {
-	header := make([]byte, headerLen)
-
-	binary.LittleEndian.PutUint32(header[0:], uint32(dataLen))
-	binary.LittleEndian.PutUint64(header[4:], uint64(time.Now().UnixNano()))
-	binary.LittleEndian.PutUint32(header[12:], allocSize)
-
-	checksum := crc(header[0:16])
-	binary.LittleEndian.PutUint32(header[16:], checksum)
+func (this *StoreItem) compact() (map[uint64]uint64, error) {
+	this.compaction.Lock()
+	defer this.compaction.Unlock()
+	this.Lock()
+	defer this.Unlock()
 
-	_, err := this.descriptor.WriteAt(header, int64(currentOffset))
+	relocationMap := map[uint64]uint64{}
 
-	if err != nil {
-		log.Fatal(err)
+	if this.unrecognized {
+		return nil, unrecognizedDataError
+	}
+	if this.offset < legacyHeaderLen {
+		return nil, errors.New("data is too small, nothing to compact")
 	}
-}
-
-func (this *StoreItem) appendPostings(name string, value uint64) {
-	p := this.CreatePostingsList(name)
-
-	data := make([]byte, 8)
-	binary.LittleEndian.PutUint64(data, value)
 
-	// add it to the end
-	offset := atomic.AddUint64(&p.offset, uint64(8)) - 8
-	p.descriptor.WriteAt(data, int64(offset))
-}
+	if !this.needsCompaction(this.offset) {
+		log.Printf("%s no compaction needed", this.root)
+		return nil, nil
+	}
 
-func (this *StoreItem) read(offset uint64) ([]byte, error) {
-	// lockless read
-	dataLen, _, err := readHeader(this.descriptor, offset)
+	compacted, err := os.OpenFile(this.path+compactedSuffix, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
 	if err != nil {
 		return nil, err
 	}
 
-	output := make([]byte, dataLen)
-	_, err = this.descriptor.ReadAt(output, int64(offset)+int64(headerLen))
+	times := &timeIndex{}
+	_, actualOffset := this.copyRecords(compacted, 0, this.offset, 0, relocationMap, times)
+
+	err = this.swapCompacted(compacted, actualOffset, relocationMap, times)
 	if err != nil {
 		return nil, err
 	}
-	return output, nil
-}
 
-func (this *StoreItem) append(allocSize uint32, dataRaw []byte) (uint64, error) {
-	if len(dataRaw) > int(allocSize) {
-		allocSize = uint32(len(dataRaw))
-	}
+	return relocationMap, nil
+}
 
-	offset := atomic.AddUint64(&this.offset, uint64(allocSize+headerLen))
+// the write lock is held only while the last few appended records are
+// copied and the files are swapped, appends and reads continue while
+// the bulk of the data is copied, modifications wait for the
+// compaction to finish
+func (this *StoreItem) compactOnline() (map[uint64]uint64, error) {
+	this.compaction.Lock()
+	defer this.compaction.Unlock()
 
-	currentOffset := offset - uint64(allocSize+headerLen)
-	_, err := this.descriptor.WriteAt(dataRaw, int64(currentOffset+headerLen))
-	if err != nil {
-		panic(err)
+	if this.unrecognized {
+		return nil, unrecognizedDataError
 	}
+	endOffset := this.completedOffset()
 
-	this.writeHeader(currentOffset, uint32(len(dataRaw)), allocSize)
+	if endOffset < legacyHeaderLen {
+		return nil, errors.New("data is too small, nothing to compact")
+	}
 
-	return currentOffset, nil
-}
+	if !this.needsCompaction(endOffset) {
+		log.Printf("%s no compaction needed", this.root)
+		return nil, nil
+	}
 
-func (this *StoreItem) modify(offset uint64, pos int32, dataRaw []byte, resetLength bool) error {
-	oldDataLen, allocSize, err := readHeader(this.descriptor, offset)
+	compacted, err := os.OpenFile(this.path+compactedSuffix, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if pos < 0 {
-		pos = int32(oldDataLen)
-	}
+	relocationMap := map[uint64]uint64{}
+	times := &timeIndex{}
+	copiedOffset := uint64(0)
+	actualOffset := uint64(0)
+	for {
+		copiedOffset, actualOffset = this.copyRecords(compacted, copiedOffset, endOffset, actualOffset, relocationMap, times)
 
-	end := uint32(pos) + uint32(len(dataRaw))
+		this.Lock()
+		endOffset = this.offset
+		if endOffset-copiedOffset < onlineCompactionCatchUp {
+			break
+		}
+		this.Unlock()
 
-	if end > allocSize {
-		return errors.New("pos+len > allocSize")
+		log.Printf("%s catching up with %d bytes appended during compaction", this.root, endOffset-copiedOffset)
 	}
+	defer this.Unlock()
 
-	_, err = this.descriptor.WriteAt(dataRaw, int64(offset+uint64(headerLen)+uint64(pos)))
+	_, actualOffset = this.copyRecords(compacted, copiedOffset, endOffset, actualOffset, relocationMap, times)
+
+	err = this.swapCompacted(compacted, actualOffset, relocationMap, times)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	if end > oldDataLen || resetLength {
-		// need to recompute the header
-		this.writeHeader(offset, end, allocSize)
-	}
-	return nil
+	return relocationMap, nil
 }
 
-func crc(b []byte) uint32 {
-	return uint32(metro.Hash64(b, 0) >> uint64(32))
+// how many bytes are allowed to be copied while holding the write
+// lock at the end of the online compaction
+const onlineCompactionCatchUp = 1024 * 1024
+
+type QueryOptions struct {
+	skipCorrupt bool
+	// newest records first
+	descending bool
+	// only records after the cursor in the requested order are
+	// returned, pass the last offset of the previous page
+	hasAfter bool
+	after    uint64
+	// stop after that many records, 0 means no limit
+	limit int
+	// counts the bytes read from append.raw
+	explain *ExplainOutput
 }
 
-type MultiStore struct {
-	stores map[string]*StoreItem
-	root   string
-	sync.RWMutex
-}
+func (this *StoreItem) ExecuteQuery(query Query, options QueryOptions, cb func(uint64, *Header, []byte) bool) error {
+	if options.descending {
+		query.reverse()
+	}
 
-func (this *MultiStore) find(storageIdentifier string) *StoreItem {
-	if storageIdentifier == "" {
-		storageIdentifier = "default"
+	doc := NOT_READY
+	if options.hasAfter {
+		target := int64(options.after)
+		if options.descending {
+			target = mirror(target)
+		}
+		doc = query.advance(target + 1)
+	} else {
+		doc = query.Next()
 	}
 
-	this.RLock()
-	storage, ok := this.stores[storageIdentifier]
-	this.RUnlock()
-	if !ok {
-		this.Lock()
-		defer this.Unlock()
-		storage, ok = this.stores[storageIdentifier]
+	matched := 0
+	for ; doc != NO_MORE; doc = query.Next() {
+		offset := uint64(doc)
+		if options.descending {
+			offset = uint64(mirror(doc))
+		}
+		output, header, err := this.readRecord(offset)
+		if options.explain != nil {
+			if header != nil {
+				options.explain.BytesRead += header.size()
+			} else {
+				options.explain.BytesRead += uint64(headerLen)
+			}
+			if header != nil && err != deletedError {
+				options.explain.BytesRead += uint64(header.dataLen)
+			}
+		}
+		if err == deletedError {
+			continue
+		}
+		if err == wrongValueChecksumError {
+			if !options.skipCorrupt {
+				return fmt.Errorf("%s at offset %d", err.Error(), offset)
+			}
+			continue
+		}
+		if err != nil {
+			break
+		}
 
-		if !ok {
-			storage = NewStorage(path.Join(this.root, storageIdentifier))
-			this.stores[storageIdentifier] = storage
+		if !cb(offset, header, output) {
+			break
+		}
+		matched++
+		if options.limit > 0 && matched >= options.limit {
+			break
 		}
 	}
-	return storage
+	return query.Err()
 }
 
-func (this *MultiStore) close(storageIdentifier string) {
-	this.Lock()
-	defer this.Unlock()
+func countDocs(query Query) (uint64, error) {
+	count := uint64(0)
+	for query.Next() != NO_MORE {
+		count++
+	}
+	return count, query.Err()
+}
+
+// counts the matches only by walking the postings, append.raw is not
+// read, so deleted records are counted until the next compaction
+//
+// newQuery is called once for the total and once per facet, the
+// facets count the matches that also have the facet tag
+func (this *StoreItem) countQuery(newQuery func() (Query, error), facets []string) (*CountOutput, error) {
+	query, err := newQuery()
+	if err != nil {
+		return nil, err
+	}
+	out := &CountOutput{}
+	out.Count, err = countDocs(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(facets) == 0 {
+		return out, nil
+	}
+
+	out.Facets = map[string]uint64{}
+	for _, tag := range facets {
+		pl := this.GetPostingsList(tag)
+		if pl == nil {
+			out.Facets[tag] = 0
+			continue
+		}
+		query, err := newQuery()
+		if err != nil {
+			return nil, err
+		}
+		out.Facets[tag], err = countDocs(newAnd([]Query{query, pl.newTermQuery()}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+const headerLen = 4 + 8 + 4 + 4 + 4 + 4 + 4
+
+// headers written before 3.0 are [dataLen 4][time 8][allocSize 4][crc 4]
+const legacyHeaderLen = 4 + 8 + 4 + 4
+
+// the top byte of the flags is the format of the header, so the 3.0
+// headers are told apart from the older ones, which are still read
+// and are upgraded by compaction
+const (
+	headerFormat     = uint32(3) << 24
+	headerFormatMask = uint32(0xff) << 24
+)
+
+const (
+	// the value checksum is set, every record written since 3.0 has it
+	flagValueChecksum = uint32(1) << iota
+	// the record is deleted, its space is reclaimed by compact()
+	flagDeleted
+)
+
+type Header struct {
+	dataLen       uint32
+	time          int64
+	allocSize     uint32
+	valueChecksum uint32
+	flags         uint32
+	// incremented by every modify, so a modify can check that the
+	// record did not change since it was read
+	version uint32
+	// written before 3.0, without value checksum, flags and version
+	legacy bool
+}
+
+func (this *Header) size() uint64 {
+	if this.legacy {
+		return legacyHeaderLen
+	}
+	return headerLen
+}
+
+func newHeader(data []byte, allocSize uint32) *Header {
+	return &Header{
+		dataLen:       uint32(len(data)),
+		time:          time.Now().UnixNano(),
+		allocSize:     allocSize,
+		valueChecksum: crc(data),
+		flags:         flagValueChecksum,
+	}
+}
+
+func (this *Header) verify(data []byte) error {
+	if this.flags&flagValueChecksum != 0 && crc(data) != this.valueChecksum {
+		return wrongValueChecksumError
+	}
+	return nil
+}
+
+var wrongChecksumError = errors.New("wrong checksum")
+var wrongValueChecksumError = errors.New("wrong value checksum")
+var deletedError = errors.New("not found, record is deleted")
+var versionConflictError = errors.New("version conflict, the record was modified")
+var noValidHeaderFoundError = errors.New("no valid header found")
+var unrecognizedDataError = errors.New("no valid header found in the data, refusing to compact")
+var legacyRecordError = &invalidInputError{"the record was written before 3.0, compact the namespace to upgrade it"}
+
+func gotoNextValidHeader(file *os.File, offset, endOffset uint64) (uint64, *Header, error) {
+	for start := offset; start < endOffset; start++ {
+		header, err := readHeader(file, start)
+		if err == nil {
+			return start, header, nil
+		} else {
+			if err == io.EOF {
+				return 0, nil, noValidHeaderFoundError
+			}
+		}
+	}
+
+	return 0, nil, noValidHeaderFoundError
+
+}
+func readHeader(file *os.File, offset uint64) (*Header, error) {
+	headerBytes := make([]byte, headerLen)
+	n, err := file.ReadAt(headerBytes, int64(offset))
+	if n < legacyHeaderLen {
+		if err == nil {
+			err = io.EOF
+		}
+		return nil, err
+	}
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	flags := binary.LittleEndian.Uint32(headerBytes[20:])
+	if n == headerLen && flags&headerFormatMask == headerFormat && binary.LittleEndian.Uint32(headerBytes[28:]) == crc(headerBytes[0:28]) {
+		return &Header{
+			dataLen:       binary.LittleEndian.Uint32(headerBytes[0:]),
+			time:          int64(binary.LittleEndian.Uint64(headerBytes[4:])),
+			allocSize:     binary.LittleEndian.Uint32(headerBytes[12:]),
+			valueChecksum: binary.LittleEndian.Uint32(headerBytes[16:]),
+			flags:         flags &^ headerFormatMask,
+			version:       binary.LittleEndian.Uint32(headerBytes[24:]),
+		}, nil
+	}
+
+	if binary.LittleEndian.Uint32(headerBytes[16:]) == crc(headerBytes[0:16]) {
+		return &Header{
+			dataLen:   binary.LittleEndian.Uint32(headerBytes[0:]),
+			time:      int64(binary.LittleEndian.Uint64(headerBytes[4:])),
+			allocSize: binary.LittleEndian.Uint32(headerBytes[12:]),
+			legacy:    true,
+		}, nil
+	}
+	return nil, wrongChecksumError
+}
+
+func writeHeader(file *os.File, currentOffset uint64, h *Header) {
+	if h.legacy {
+		writeLegacyHeader(file, currentOffset, h)
+		return
+	}
+	header := make([]byte, headerLen)
+
+	binary.LittleEndian.PutUint32(header[0:], h.dataLen)
+	binary.LittleEndian.PutUint64(header[4:], uint64(h.time))
+	binary.LittleEndian.PutUint32(header[12:], h.allocSize)
+	binary.LittleEndian.PutUint32(header[16:], h.valueChecksum)
+	binary.LittleEndian.PutUint32(header[20:], h.flags|headerFormat)
+	binary.LittleEndian.PutUint32(header[24:], h.version)
+
+	checksum := crc(header[0:28])
+	binary.LittleEndian.PutUint32(header[28:], checksum)
+
+	_, err := file.WriteAt(header, int64(currentOffset))
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// a modify of a record written before 3.0 keeps its header format
+func writeLegacyHeader(file *os.File, currentOffset uint64, h *Header) {
+	header := make([]byte, legacyHeaderLen)
+
+	binary.LittleEndian.PutUint32(header[0:], h.dataLen)
+	binary.LittleEndian.PutUint64(header[4:], uint64(h.time))
+	binary.LittleEndian.PutUint32(header[12:], h.allocSize)
+	binary.LittleEndian.PutUint32(header[16:], crc(header[0:16]))
+
+	_, err := file.WriteAt(header, int64(currentOffset))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// blocks until all appends that reserved their offset before us have
+// written their postings
+func (this *StoreItem) waitForPostingsTurn(ticket uint64) {
+	this.postingsLock.Lock()
+	for this.postingsWritten != ticket {
+		this.postingsTurn.Wait()
+	}
+	this.postingsLock.Unlock()
+}
+
+func (this *StoreItem) postingsDone() {
+	this.postingsLock.Lock()
+	this.postingsWritten++
+	this.postingsTurn.Broadcast()
+	this.postingsLock.Unlock()
+}
+
+func (this *StoreItem) read(offset uint64) ([]byte, error) {
+	output, _, err := this.readRecord(offset)
+	return output, err
+}
+
+// returns wrongValueChecksumError or deletedError together with the
+// header if the stored value is corrupted or deleted, so the caller
+// can skip it
+func (this *StoreItem) readRecord(offset uint64) ([]byte, *Header, error) {
+	output, header, err := this.readRecordOnce(offset)
+	if err == wrongValueChecksumError {
+		// modify writes the value before the header with its checksum,
+		// so we might have read it in between; modifies hold the modify
+		// lock, after waiting for it the record is read again
+		this.modifyLock.Lock()
+		defer this.modifyLock.Unlock()
+		output, header, err = this.readRecordOnce(offset)
+	}
+	return output, header, err
+}
+
+func (this *StoreItem) readRecordOnce(offset uint64) ([]byte, *Header, error) {
+	// the read lock only protects against compaction swapping the file
+	this.RLock()
+	defer this.RUnlock()
+
+	header, err := readHeader(this.descriptor, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.flags&flagDeleted != 0 {
+		return nil, header, deletedError
+	}
+
+	output := make([]byte, header.dataLen)
+	_, err = this.descriptor.ReadAt(output, int64(offset+header.size()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = header.verify(output)
+	if err != nil {
+		return nil, header, err
+	}
+	return output, header, nil
+}
+
+func (this *StoreItem) append(allocSize uint32, dataRaw []byte, tags ...string) (uint64, error) {
+	return this.appendItem(&Append{AllocSize: allocSize, Data: dataRaw, Tags: tags})
+}
+
+// appends the record and indexes its tags, numeric fields and key
+func (this *StoreItem) appendItem(item *Append) (uint64, error) {
+	allocSize := item.AllocSize
+	dataRaw := item.Data
+	if len(dataRaw) > int(allocSize) {
+		allocSize = uint32(len(dataRaw))
+	}
+
+	err := validateFields(item.Fields)
+	if err != nil {
+		return 0, err
+	}
+	err = validateKey(item.Key)
+	if err != nil {
+		return 0, err
+	}
+
+	postings := make([]*PostingsList, len(item.Tags))
+	for i, t := range item.Tags {
+		postings[i] = this.CreatePostingsList(t)
+	}
+	fields := make(map[*NumericIndex]float64, len(item.Fields))
+	for name, value := range item.Fields {
+		fields[this.CreateNumericIndex(name)] = value
+	}
+	indexed := len(postings) > 0 || len(fields) > 0
+
+	// the read lock is held until the record and its postings are
+	// written, so online compaction can catch up with them
+	this.RLock()
+	defer this.RUnlock()
+
+	var offset, ticket uint64
+	if indexed {
+		this.postingsLock.Lock()
+		offset = atomic.AddUint64(&this.offset, uint64(allocSize+headerLen))
+		ticket = this.postingsTicket
+		this.postingsTicket++
+		this.postingsLock.Unlock()
+		// a failed write must not block the appends after us forever,
+		// the turn is ours until postingsDone, so waiting again is fine
+		defer func() {
+			this.waitForPostingsTurn(ticket)
+			this.postingsDone()
+		}()
+	} else {
+		offset = atomic.AddUint64(&this.offset, uint64(allocSize+headerLen))
+	}
+
+	currentOffset := offset - uint64(allocSize+headerLen)
+	_, err = this.descriptor.WriteAt(dataRaw, int64(currentOffset+headerLen))
+	if err != nil {
+		panic(err)
+	}
+
+	header := newHeader(dataRaw, allocSize)
+	writeHeader(this.descriptor, currentOffset, header)
+	this.times.add(header.time, currentOffset)
+
+	if item.Key != "" {
+		this.keys.put(item.Key, currentOffset)
+	}
+
+	if indexed {
+		this.waitForPostingsTurn(ticket)
+		durable := this.durability.Mode != durabilityNone
+		for _, p := range postings {
+			p.append(currentOffset, durable)
+		}
+		for n, value := range fields {
+			n.append(value, currentOffset)
+		}
+	}
+	this.written()
+	this.notifyTailers()
+
+	return currentOffset, nil
+}
+
+func (this *StoreItem) modify(offset uint64, pos int32, dataRaw []byte, resetLength bool) error {
+	return this.modifyItem(&Modify{Offset: offset, Pos: pos, Data: dataRaw, ResetLength: resetLength})
+}
+
+// modifies the record at the offset, or the latest record appended
+// with the key; with checkVersion it fails with versionConflictError
+// if the record was modified since expectedVersion was read
+func (this *StoreItem) modifyItem(item *Modify) error {
+	this.compaction.RLock()
+	defer this.compaction.RUnlock()
+	this.modifyLock.Lock()
+	defer this.modifyLock.Unlock()
+
+	offset := item.Offset
+	if item.Key != "" {
+		var ok bool
+		offset, ok = this.keys.get(item.Key)
+		if !ok {
+			return keyNotFoundError
+		}
+	}
+	_, err := this.modifyAt(offset, item, false)
+	return err
+}
+
+var outOfAllocSizeError = &invalidInputError{"pos+len > allocSize"}
+
+// returns where the modify writes in a record with the header
+func checkModify(header *Header, item *Modify) (uint32, uint32, error) {
+	if header.flags&flagDeleted != 0 {
+		return 0, 0, deletedError
+	}
+	if item.CheckVersion && header.legacy {
+		return 0, 0, legacyRecordError
+	}
+	if item.CheckVersion && header.version != item.ExpectedVersion {
+		return 0, 0, versionConflictError
+	}
+
+	pos := uint32(item.Pos)
+	if item.Pos < 0 {
+		pos = header.dataLen
+	}
+
+	end := pos + uint32(len(item.Data))
+	if end > header.allocSize {
+		return 0, 0, outOfAllocSizeError
+	}
+	return pos, end, nil
+}
+
+// modifies the record at the offset and, if undoable, returns how to
+// undo it; the caller must hold the compaction read lock and the modify
+// lock
+func (this *StoreItem) modifyAt(offset uint64, item *Modify, undoable bool) (*undo, error) {
+	this.RLock()
+	defer this.RUnlock()
+
+	header, err := readHeader(this.descriptor, offset)
+	if err != nil {
+		return nil, err
+	}
+	pos, end, err := checkModify(header, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *undo
+	if undoable {
+		previous := *header
+		u = &undo{store: this, offset: offset, header: &previous, pos: pos}
+	}
+	if undoable && pos < header.dataLen {
+		overwritten := end
+		if overwritten > header.dataLen {
+			overwritten = header.dataLen
+		}
+		u.data = make([]byte, overwritten-pos)
+		_, err = this.descriptor.ReadAt(u.data, int64(offset+header.size())+int64(pos))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dataRaw := item.Data
+	_, err = this.descriptor.WriteAt(dataRaw, int64(offset+header.size()+uint64(pos)))
+	if err != nil {
+		panic(err)
+	}
+
+	if end > header.dataLen || item.ResetLength {
+		header.dataLen = end
+	}
+
+	// need to recompute the value checksum, if the whole value was
+	// replaced there is no need to read it back
+	value := dataRaw
+	if pos != 0 || end != header.dataLen {
+		value = make([]byte, header.dataLen)
+		_, err = this.descriptor.ReadAt(value, int64(offset+header.size()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	header.valueChecksum = crc(value)
+	header.flags |= flagValueChecksum
+	header.version++
+	writeHeader(this.descriptor, offset, header)
+
+	this.written()
+	return u, nil
+}
+
+// flags the record as deleted, it is hidden from read, scan and query
+// and dropped by the next compaction
+func (this *StoreItem) deleteRecord(offset uint64) error {
+	this.compaction.RLock()
+	defer this.compaction.RUnlock()
+	this.modifyLock.Lock()
+	defer this.modifyLock.Unlock()
+
+	_, err := this.deleteAt(offset)
+	return err
+}
+
+// the caller must hold the compaction read lock and the modify lock
+func (this *StoreItem) deleteAt(offset uint64) (*undo, error) {
+	this.RLock()
+	defer this.RUnlock()
+
+	header, err := readHeader(this.descriptor, offset)
+	if err != nil {
+		return nil, err
+	}
+	if header.legacy {
+		return nil, legacyRecordError
+	}
+	previous := *header
+
+	header.flags |= flagDeleted
+	writeHeader(this.descriptor, offset, header)
+
+	this.written()
+	return &undo{store: this, offset: offset, header: &previous}, nil
+}
+
+func crc(b []byte) uint32 {
+	return uint32(metro.Hash64(b, 0) >> uint64(32))
+}
+
+// stops the syncer and the tailers and closes the files
+func (this *StoreItem) close() {
+	this.shutdown()
+	this.Lock()
+	defer this.Unlock()
+	this.closeFiles()
+}
+
+// must be called with the write lock held
+func (this *StoreItem) closeFiles() {
+	this.descriptor.Close()
+	for name, i := range this.index {
+		log.Printf("closing: %s/%s.postings", this.root, name)
+		i.close()
+	}
+	this.index = make(map[string]*PostingsList)
+	for name, n := range this.fields {
+		log.Printf("closing: %s/%s.numeric", this.root, name)
+		n.close()
+	}
+	this.fields = make(map[string]*NumericIndex)
+	this.keys.close()
+	log.Printf("closing: %s", this.path)
+}
+
+type MultiStore struct {
+	stores     map[string]*StoreItem
+	root       string
+	durability Durability
+	sync.RWMutex
+}
+
+func (this *MultiStore) find(storageIdentifier string) *StoreItem {
+	if storageIdentifier == "" {
+		storageIdentifier = "default"
+	}
+
+	this.RLock()
+	storage, ok := this.stores[storageIdentifier]
+	this.RUnlock()
+	if !ok {
+		this.Lock()
+		defer this.Unlock()
+		storage, ok = this.stores[storageIdentifier]
+
+		if !ok {
+			storage = NewStorage(path.Join(this.root, storageIdentifier), this.durability)
+			this.stores[storageIdentifier] = storage
+		}
+	}
+	return storage
+}
+
+func (this *MultiStore) close(storageIdentifier string) {
+	this.Lock()
+	defer this.Unlock()
 	if storageIdentifier == "" {
 		storageIdentifier = "default"
 	}
 	storage, ok := this.stores[storageIdentifier]
 	if ok {
-		storage.descriptor.Close()
-		storage.Lock()
-		for name, i := range storage.index {
-			log.Printf("closing: %s/%s.postings", storage.root, name)
-			i.descriptor.Close()
-		}
-		storage.index = make(map[string]*PostingsList)
-		storage.Unlock()
-		log.Printf("closing: %s", storage.path)
+		storage.close()
 	}
 	delete(this.stores, storageIdentifier)
 }
@@ -470,35 +1311,50 @@ func (this *MultiStore) delete(storageIdentifier string) {
 	}
 	storage, ok := this.stores[storageIdentifier]
 	if ok {
-		storage.descriptor.Close()
-		storage.Lock()
-		for name, i := range storage.index {
-			log.Printf("closing (tobe deleted): %s/%s.postings", storage.root, name)
-			i.descriptor.Close()
-		}
-		storage.index = make(map[string]*PostingsList)
-		storage.Unlock()
-		log.Printf("closing (tobe deleted): %s", storage.path)
+		log.Printf("closing (tobe deleted): %s", storage.root)
+		storage.close()
 		os.RemoveAll(storage.root)
 	}
 	delete(this.stores, storageIdentifier)
 }
 
+// without a durability mode nothing is stored, so the namespace keeps
+// using the server default; a missing syncInterval is the server one
+func (this *MultiStore) create(storageIdentifier string, durability Durability) error {
+	if durability.Mode == "" {
+		this.find(storageIdentifier)
+		return nil
+	}
+	if durability.SyncInterval == 0 {
+		durability.SyncInterval = this.durability.SyncInterval
+	}
+	err := durability.validate()
+	if err != nil {
+		return err
+	}
+	return this.find(storageIdentifier).setDurability(durability, true)
+}
+
 func (this *MultiStore) stats(storageIdentifier string) *StatsOutput {
 	return this.find(storageIdentifier).stats()
 }
 
-func (this *MultiStore) scan(storageIdentifier string, cb func(uint64, []byte) bool) {
-	this.find(storageIdentifier).scan(cb)
+func (this *MultiStore) scan(storageIdentifier string, options ScanOptions, cb func(uint64, *Header, []byte) bool) (uint64, error) {
+	return this.find(storageIdentifier).scan(options, cb)
 }
 
-func (this *MultiStore) compact(storageIdentifier string) error {
-	_, e := this.find(storageIdentifier).compact()
+func (this *MultiStore) compact(storageIdentifier string, online bool) error {
+	storage := this.find(storageIdentifier)
+	if online {
+		_, e := storage.compactOnline()
+		return e
+	}
+	_, e := storage.compact()
 	return e
 }
 
-func (this *MultiStore) ExecuteQuery(storageIdentifier string, query Query, cb func(uint64, []byte) bool) {
-	this.find(storageIdentifier).ExecuteQuery(query, cb)
+func (this *MultiStore) ExecuteQuery(storageIdentifier string, query Query, options QueryOptions, cb func(uint64, *Header, []byte) bool) error {
+	return this.find(storageIdentifier).ExecuteQuery(query, options, cb)
 }
 
 func makeTimestamp() int64 {
@@ -517,109 +1373,491 @@ func Log(handler http.Handler, tookThresh int64) http.Handler {
 }
 
 const namespaceKey = "namespace"
+const skipCorruptKey = "skipCorrupt"
+const withTimeKey = "withTime"
+const fromTimeKey = "from_time"
+const toTimeKey = "to_time"
+const fromKey = "from"
+const toKey = "to"
+const limitKey = "limit"
+const afterKey = "after"
+const orderKey = "order"
+const countKey = "count"
+const facetKey = "facet"
+const explainKey = "explain"
+const queryStringKey = "q"
+
+const defaultFetchLimit = 100
+
+func int64Param(r *http.Request, key string) (int64, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return 0, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("[%s] must be an integer: %s", key, err.Error())
+	}
+	return i, nil
+}
+
+// writes the records in [len 4 bytes][offset 8 bytes]data format,
+// or [len 4 bytes][offset 8 bytes][time 8 bytes]data with withTime
+func streamRecords(w io.Writer, withTime bool) func(uint64, *Header, []byte) bool {
+	headerSize := 12
+	if withTime {
+		headerSize = 20
+	}
+	header := make([]byte, headerSize)
+	return func(offset uint64, h *Header, data []byte) bool {
+		binary.LittleEndian.PutUint32(header[0:], uint32(len(data)))
+		binary.LittleEndian.PutUint64(header[4:], offset)
+		if withTime {
+			binary.LittleEndian.PutUint64(header[12:], uint64(h.time))
+		}
+
+		_, err := w.Write(header)
+		if err != nil {
+			return false
+		}
+		_, err = w.Write(data)
+		if err != nil {
+			return false
+		}
+		return true
+	}
+}
+
+// streaming endpoints have already sent the status when they hit an
+// error, so it is sent in a trailer
+const errorTrailer = "X-Rochefort-Error"
+
+// where the next /scan should continue from
+const nextOffsetTrailer = "X-Rochefort-Next-Offset"
 
 func main() {
 	var pbind = flag.String("bind", ":8000", "address to bind to")
 	var proot = flag.String("root", "/tmp/rochefort", "root directory")
 	var ptookThresh = flag.Int("logSlowerThan", 5, "only log queries slower than N milliseconds")
+	var pdurability = flag.String("durability", durabilityNone, "default durability of the namespaces: none, periodic or commit")
+	var psyncInterval = flag.Int("syncInterval", 1000, "fsync every N milliseconds when durability is periodic")
+	flag.IntVar(&maxExpansions, "maxExpansions", maxExpansions, "maximum number of tags a prefix or wildcard query can expand to")
 	flag.Parse()
 
+	durability := Durability{
+		Mode:         *pdurability,
+		SyncInterval: uint32(*psyncInterval),
+	}
+	err := durability.validate()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	multiStore := &MultiStore{
-		stores: make(map[string]*StoreItem),
-		root:   *proot,
+		stores:     make(map[string]*StoreItem),
+		root:       *proot,
+		durability: durability,
+	}
+
+	os.MkdirAll(*proot, 0700)
+	namespaces, err := ioutil.ReadDir(*proot)
+	if err != nil {
+		panic(err)
+	}
+	// open all files in the root
+	for _, namespace := range namespaces {
+		if namespace.IsDir() {
+			// XXX: race against sigterm
+			go func(namespaceName string) {
+				files, err := ioutil.ReadDir(path.Join(*proot, namespaceName))
+				if err == nil {
+					for _, file := range files {
+						if strings.HasSuffix(file.Name(), ".raw") {
+							multiStore.find(namespaceName)
+							return
+						}
+					}
+				}
+			}(namespace.Name())
+
+		}
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		log.Printf("\nReceived an interrupt, stopping services...\n")
+		multiStore.Lock() // dont unlock it
+		for _, storage := range multiStore.stores {
+			storage.Lock() // dont unlock it
+			storage.closeFiles()
+		}
+		os.Exit(0)
+
+	}()
+
+	unmarshalNamespaceInput := func(w http.ResponseWriter, r *http.Request) (*NamespaceInput, bool) {
+		defer r.Body.Close()
+		dataRaw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return nil, false
+		}
+		input := &NamespaceInput{}
+		err = input.Unmarshal(dataRaw)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return nil, false
+		}
+		return input, true
+
 	}
 
-	os.MkdirAll(*proot, 0700)
-	namespaces, err := ioutil.ReadDir(*proot)
-	if err != nil {
-		panic(err)
-	}
-	// open all files in the root
-	for _, namespace := range namespaces {
-		if namespace.IsDir() {
-			// XXX: race against sigterm
-			go func(namespaceName string) {
-				files, err := ioutil.ReadDir(path.Join(*proot, namespaceName))
-				if err == nil {
-					for _, file := range files {
-						if strings.HasSuffix(file.Name(), ".raw") {
-							multiStore.find(namespaceName)
-							return
-						}
-					}
+	http.HandleFunc("/close", func(w http.ResponseWriter, r *http.Request) {
+		input, success := unmarshalNamespaceInput(w, r)
+		if !success {
+			return
+		}
+
+		multiStore.close(input.Namespace)
+
+		w.Header().Set("Content-Type", "application/protobuf")
+		out := &SuccessOutput{}
+		m, err := out.Marshal()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(m)
+	})
+
+	http.HandleFunc("/delete", func(w http.ResponseWriter, r *http.Request) {
+		input, success := unmarshalNamespaceInput(w, r)
+		if !success {
+			return
+		}
+
+		multiStore.delete(input.Namespace)
+
+		w.Header().Set("Content-Type", "application/protobuf")
+		out := &SuccessOutput{}
+		m, err := out.Marshal()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(m)
+	})
+
+	http.HandleFunc("/create", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		dataRaw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		input := &CreateInput{}
+		err = input.Unmarshal(dataRaw)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		err = multiStore.create(input.Namespace, Durability{
+			Mode:         input.Durability,
+			SyncInterval: input.SyncInterval,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/protobuf")
+		out := &SuccessOutput{Success: true}
+		m, err := out.Marshal()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(m)
+	})
+
+	http.HandleFunc("/compact", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		dataRaw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		input := &CompactInput{}
+		err = input.Unmarshal(dataRaw)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		err = multiStore.compact(input.Namespace, input.Online)
+		if err != nil {
+			log.Printf("compaction of %s failed, err: %s", input.Namespace, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/protobuf")
+		out := &SuccessOutput{}
+		m, err := out.Marshal()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(m)
+	})
+
+	http.HandleFunc("/set", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		dataRaw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		input := AppendInput{}
+		err = input.Unmarshal(dataRaw)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		var out *AppendOutput
+		var touched map[*StoreItem]bool
+		if input.Transactional {
+			out, touched, err = multiStore.applyTransaction(&input)
+		} else {
+			out, touched, err = multiStore.applyBatch(&input)
+		}
+		if err != nil {
+			w.WriteHeader(statusCode(err))
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		for storage := range touched {
+			if storage.commitsOnWrite() {
+				err := storage.commit()
+				if err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(err.Error()))
+					return
+				}
+			}
+		}
+		m, err := out.Marshal()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/protobuf")
+		w.Write(m)
+
+	})
+
+	http.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		dataRaw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		input := GetInput{}
+		err = input.Unmarshal(dataRaw)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		if input.GetPayload != nil {
+			var last *StoreItem
+			lastns := ""
+			out := &GetOutput{
+				Data: make([][]byte, len(input.GetPayload)),
+			}
+			if input.WithTime {
+				out.Time = make([]int64, len(input.GetPayload))
+			}
+			if input.WithVersion {
+				out.Version = make([]uint32, len(input.GetPayload))
+			}
+
+			for idx, item := range input.GetPayload {
+				if last == nil || lastns != item.Namespace {
+					lastns = item.Namespace
+					last = multiStore.find(item.Namespace)
+				}
+				var data []byte
+				var header *Header
+				if item.Key != "" {
+					data, header, err = last.readKey(item.Key)
+				} else {
+					data, header, err = last.readRecord(item.Offset)
+				}
+				if err == wrongValueChecksumError && input.SkipCorrupt {
+					continue
+				}
+				if err == deletedError || err == keyNotFoundError {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(err.Error()))
+					return
+				}
+				if err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(err.Error()))
+					return
+				}
+
+				out.Data[idx] = data
+				if input.WithTime {
+					out.Time[idx] = header.time
 				}
-			}(namespace.Name())
+				if input.WithVersion {
+					out.Version[idx] = header.version
+				}
+			}
+
+			m, err := out.Marshal()
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
+			}
 
+			w.Header().Set("Content-Type", "application/protobuf")
+			w.Write(m)
 		}
-	}
+	})
 
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-	go func() {
-		<-sigs
-		log.Printf("\nReceived an interrupt, stopping services...\n")
-		multiStore.Lock() // dont unlock it
-		for _, storage := range multiStore.stores {
-			storage.Lock() // dont unlock it
-			storage.descriptor.Close()
-			for name, i := range storage.index {
-				log.Printf("closing: %s/%s.postings", storage.root, name)
-				i.descriptor.Close()
+	http.HandleFunc("/scan", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Add("Trailer", errorTrailer)
+		w.Header().Add("Trailer", nextOffsetTrailer)
+
+		options := ScanOptions{
+			skipCorrupt: r.URL.Query().Get(skipCorruptKey) == "true",
+		}
+		var from, to, limit int64
+		var err error
+		for key, v := range map[string]*int64{
+			fromKey:     &from,
+			toKey:       &to,
+			limitKey:    &limit,
+			fromTimeKey: &options.fromTime,
+			toTimeKey:   &options.toTime,
+		} {
+			*v, err = int64Param(r, key)
+			if err == nil && *v < 0 {
+				err = fmt.Errorf("[%s] must not be negative", key)
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
 			}
-			log.Printf("closing: %s", storage.path)
 		}
-		os.Exit(0)
+		options.from = uint64(from)
+		options.to = uint64(to)
+		options.limit = int(limit)
 
-	}()
+		cb := streamRecords(w, r.URL.Query().Get(withTimeKey) == "true")
+		next, err := multiStore.scan(r.URL.Query().Get(namespaceKey), options, cb)
+		if err != nil {
+			w.Header().Set(errorTrailer, err.Error())
+		}
+		w.Header().Set(nextOffsetTrailer, strconv.FormatUint(next, 10))
+	})
 
-	unmarshalNamespaceInput := func(w http.ResponseWriter, r *http.Request) (*NamespaceInput, bool) {
+	http.HandleFunc("/group/fetch", func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
 		dataRaw, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
-			return nil, false
+			return
 		}
-		input := &NamespaceInput{}
+		input := &FetchInput{}
 		err = input.Unmarshal(dataRaw)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
-			return nil, false
+			return
 		}
-		return input, true
-
-	}
 
-	http.HandleFunc("/close", func(w http.ResponseWriter, r *http.Request) {
-		input, success := unmarshalNamespaceInput(w, r)
-		if !success {
+		limit := int(input.Limit)
+		if limit == 0 {
+			limit = defaultFetchLimit
+		}
+		out, err := multiStore.find(input.Namespace).fetchGroup(input.Group, limit, input.SkipCorrupt)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
 			return
 		}
 
-		multiStore.close(input.Namespace)
-
-		w.Header().Set("Content-Type", "application/protobuf")
-		out := &SuccessOutput{}
 		m, err := out.Marshal()
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
+
+		w.Header().Set("Content-Type", "application/protobuf")
 		w.Write(m)
 	})
 
-	http.HandleFunc("/delete", func(w http.ResponseWriter, r *http.Request) {
-		input, success := unmarshalNamespaceInput(w, r)
-		if !success {
+	http.HandleFunc("/group/commit", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		dataRaw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		input := &CommitInput{}
+		err = input.Unmarshal(dataRaw)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
 			return
 		}
 
-		multiStore.delete(input.Namespace)
+		err = multiStore.find(input.Namespace).commitGroup(input.Group, input.Offset, input.Generation)
+		if err == compactedSinceFetchError {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
 
 		w.Header().Set("Content-Type", "application/protobuf")
-		out := &SuccessOutput{}
+		out := &SuccessOutput{Success: true}
 		m, err := out.Marshal()
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -629,26 +1867,25 @@ func main() {
 		w.Write(m)
 	})
 
-	http.HandleFunc("/compact", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/group/list", func(w http.ResponseWriter, r *http.Request) {
 		input, success := unmarshalNamespaceInput(w, r)
 		if !success {
 			return
 		}
 
-		multiStore.compact(input.Namespace)
-
-		w.Header().Set("Content-Type", "application/protobuf")
-		out := &SuccessOutput{}
+		out := multiStore.find(input.Namespace).listGroups()
 		m, err := out.Marshal()
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
+
+		w.Header().Set("Content-Type", "application/protobuf")
 		w.Write(m)
 	})
 
-	http.HandleFunc("/set", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/verify", func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
 		dataRaw, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -656,54 +1893,19 @@ func main() {
 			w.Write([]byte(err.Error()))
 			return
 		}
-		input := AppendInput{}
+		input := &VerifyInput{}
 		err = input.Unmarshal(dataRaw)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
-		var last *StoreItem
-		lastns := ""
-		out := &AppendOutput{}
 
-		if input.AppendPayload != nil {
-			out.Offset = make([]uint64, len(input.AppendPayload))
-			for idx, item := range input.AppendPayload {
-				if last == nil || lastns != item.Namespace {
-					lastns = item.Namespace
-					last = multiStore.find(item.Namespace)
-				}
-				offset, err := last.append(item.AllocSize, item.Data)
-				if err != nil {
-					w.WriteHeader(http.StatusInternalServerError)
-					w.Write([]byte(err.Error()))
-					return
-				}
-
-				out.Offset[idx] = offset
-				if item.Tags != nil {
-					for _, t := range item.Tags {
-						last.appendPostings(t, offset)
-					}
-				}
-			}
-		}
-
-		if input.ModifyPayload != nil {
-			for _, item := range input.ModifyPayload {
-				if last == nil || lastns != item.Namespace {
-					lastns = item.Namespace
-					last = multiStore.find(item.Namespace)
-				}
-				err := last.modify(item.Offset, item.Pos, item.Data, item.ResetLength)
-				if err != nil {
-					w.WriteHeader(http.StatusInternalServerError)
-					w.Write([]byte(err.Error()))
-					return
-				}
-				out.ModifiedCount++
-			}
+		out, err := multiStore.find(input.Namespace).verifyPostings(input.Repair)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
 		}
 		m, err := out.Marshal()
 		if err != nil {
@@ -714,10 +1916,9 @@ func main() {
 
 		w.Header().Set("Content-Type", "application/protobuf")
 		w.Write(m)
-
 	})
 
-	http.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
 		dataRaw, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -725,71 +1926,64 @@ func main() {
 			w.Write([]byte(err.Error()))
 			return
 		}
-		input := GetInput{}
+		input := &TagsInput{}
 		err = input.Unmarshal(dataRaw)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
-		if input.GetPayload != nil {
-			var last *StoreItem
-			lastns := ""
-			out := &GetOutput{
-				Data: make([][]byte, len(input.GetPayload)),
-			}
-
-			for idx, item := range input.GetPayload {
-				if last == nil || lastns != item.Namespace {
-					lastns = item.Namespace
-					last = multiStore.find(item.Namespace)
-				}
-				item, err := last.read(item.Offset)
-				if err != nil {
-					w.WriteHeader(http.StatusInternalServerError)
-					w.Write([]byte(err.Error()))
-					return
-				}
-
-				out.Data[idx] = item
-			}
-
-			m, err := out.Marshal()
-			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(err.Error()))
-				return
-			}
 
-			w.Header().Set("Content-Type", "application/protobuf")
-			w.Write(m)
+		limit := int(input.Limit)
+		if limit == 0 {
+			limit = defaultFetchLimit
+		}
+		out, err := multiStore.find(input.Namespace).listTags(input.Prefix, input.Sort, int(input.From), limit)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
 		}
+		m, err := out.Marshal()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/protobuf")
+		w.Write(m)
 	})
 
-	http.HandleFunc("/scan", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/tail", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Trailer", errorTrailer)
 
-		header := make([]byte, 12)
-		cb := func(offset uint64, data []byte) bool {
-			binary.LittleEndian.PutUint32(header[0:], uint32(len(data)))
-			binary.LittleEndian.PutUint64(header[4:], offset)
+		from, err := int64Param(r, fromKey)
+		if err == nil && from < 0 {
+			err = fmt.Errorf("[%s] must not be negative", fromKey)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
 
-			_, err := w.Write(header)
-			if err != nil {
-				return false
+		flush := func() {
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
 			}
-			_, err = w.Write(data)
-			if err != nil {
-				return false
-			}
-			return true
 		}
-		multiStore.scan(r.URL.Query().Get(namespaceKey), cb)
+
+		cb := streamRecords(w, r.URL.Query().Get(withTimeKey) == "true")
+		stored := multiStore.find(r.URL.Query().Get(namespaceKey))
+		err = stored.tail(uint64(from), r.URL.Query().Get(skipCorruptKey) == "true", r.Context().Done(), cb, flush)
+		if err != nil {
+			w.Header().Set(errorTrailer, err.Error())
+		}
 	})
 
 	http.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/octet-stream")
-
 		defer r.Body.Close()
 		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -799,14 +1993,43 @@ func main() {
 		}
 
 		var decoded map[string]interface{}
-		err = json.Unmarshal(body, &decoded)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
-			return
+		if r.URL.Query().Get(queryStringKey) != "" {
+			decoded, err = parseQueryString(r.URL.Query().Get(queryStringKey))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+		} else {
+			err = json.Unmarshal(body, &decoded)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
+			}
 		}
 		stored := multiStore.find(r.URL.Query().Get(namespaceKey))
 
+		if r.URL.Query().Get(countKey) == "true" {
+			out, err := stored.countQuery(func() (Query, error) {
+				return fromJSON(stored, decoded)
+			}, r.URL.Query()[facetKey])
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			m, err := out.Marshal()
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			w.Header().Set("Content-Type", "application/protobuf")
+			w.Write(m)
+			return
+		}
+
 		query, err := fromJSON(stored, decoded)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -814,22 +2037,63 @@ func main() {
 			return
 		}
 
-		header := make([]byte, 12)
-		cb := func(offset uint64, data []byte) bool {
-			binary.LittleEndian.PutUint32(header[0:], uint32(len(data)))
-			binary.LittleEndian.PutUint64(header[4:], offset)
+		options := QueryOptions{
+			skipCorrupt: r.URL.Query().Get(skipCorruptKey) == "true",
+			hasAfter:    r.URL.Query().Get(afterKey) != "",
+		}
+		switch r.URL.Query().Get(orderKey) {
+		case "", "asc":
+		case "desc":
+			options.descending = true
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("[order] must be asc or desc"))
+			return
+		}
+		var after, limit int64
+		for key, v := range map[string]*int64{
+			afterKey: &after,
+			limitKey: &limit,
+		} {
+			*v, err = int64Param(r, key)
+			if err == nil && *v < 0 {
+				err = fmt.Errorf("[%s] must not be negative", key)
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+		}
+		options.after = uint64(after)
+		options.limit = int(limit)
 
-			_, err := w.Write(header)
+		if r.URL.Query().Get(explainKey) == "true" {
+			out, err := stored.explainQuery(query, options)
 			if err != nil {
-				return false
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
 			}
-			_, err = w.Write(data)
+			m, err := out.Marshal()
 			if err != nil {
-				return false
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
 			}
-			return true
+			w.Header().Set("Content-Type", "application/protobuf")
+			w.Write(m)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Trailer", errorTrailer)
+
+		cb := streamRecords(w, r.URL.Query().Get(withTimeKey) == "true")
+		err = stored.ExecuteQuery(query, options, cb)
+		if err != nil {
+			w.Header().Set(errorTrailer, err.Error())
 		}
-		stored.ExecuteQuery(query, cb)
 	})
 
 	http.HandleFunc("/stat", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"testing"
+)
+
+func TestDeleteRecord(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_delete_record_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	offsets := []uint64{}
+	for i := 0; i < 3; i++ {
+		offset, _ := storage.append(0, []byte(fmt.Sprintf("%d", i)), "a")
+		offsets = append(offsets, offset)
+	}
+
+	err := storage.deleteRecord(offsets[1])
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	_, err = storage.read(offsets[1])
+	if err != deletedError {
+		t.Logf("expected deletedError, got %v", err)
+		t.FailNow()
+	}
+	err = storage.modify(offsets[1], 0, []byte("x"), false)
+	if err != deletedError {
+		t.Logf("expected deletedError, got %v", err)
+		t.FailNow()
+	}
+
+	scanned := []string{}
+	storage.scan(ScanOptions{}, func(offset uint64, header *Header, data []byte) bool {
+		scanned = append(scanned, string(data))
+		return true
+	})
+	if len(scanned) != 2 || scanned[0] != "0" || scanned[1] != "2" {
+		t.Logf("unexpected scan %v", scanned)
+		t.FailNow()
+	}
+
+	found := 0
+	storage.ExecuteQuery(storage.GetPostingsList("a").newTermQuery(), QueryOptions{}, func(offset uint64, header *Header, data []byte) bool {
+		found++
+		return true
+	})
+	if found != 2 {
+		t.Logf("expected 2 hits, got %d", found)
+		t.FailNow()
+	}
+
+	relocationMap, err := storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if _, ok := relocationMap[offsets[1]]; ok {
+		t.Log("deleted record survived the compaction")
+		t.FailNow()
+	}
+	if storage.offset != 2*(uint64(headerLen)+1) {
+		t.Logf("unexpected size after compaction %d", storage.offset)
+		t.FailNow()
+	}
+	postings := query(storage.GetPostingsList("a").newTermQuery())
+	if len(postings) != 2 {
+		t.Logf("expected 2 postings, got %d", len(postings))
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestConditionalModify(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_conditional_modify_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	offset, _ := storage.append(0, make([]byte, 8))
+
+	// workers increment the counter stored in the record, retrying on
+	// conflicts
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; {
+				data, header, err := storage.readRecord(offset)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				counter := make([]byte, 8)
+				binary.LittleEndian.PutUint64(counter, binary.LittleEndian.Uint64(data)+1)
+				err = storage.modifyItem(&Modify{Offset: offset, Data: counter, CheckVersion: true, ExpectedVersion: header.version})
+				if err == versionConflictError {
+					continue
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				i++
+			}
+		}()
+	}
+	wg.Wait()
+
+	data, header, err := storage.readRecord(offset)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if binary.LittleEndian.Uint64(data) != 800 || header.version != 800 {
+		t.Logf("expected 800 increments, got counter %d version %d", binary.LittleEndian.Uint64(data), header.version)
+		t.FailNow()
+	}
+
+	err = storage.modifyItem(&Modify{Offset: offset, Data: []byte("x"), CheckVersion: true, ExpectedVersion: 799})
+	if err != versionConflictError {
+		t.Logf("expected versionConflictError, got %v", err)
+		t.FailNow()
+	}
+
+	// the version survives compaction
+	storage.deleteRecord(offset)
+	offset, _ = storage.append(0, []byte("x"))
+	storage.modify(offset, 0, []byte("y"), false)
+	relocationMap, _ := storage.compact()
+	_, header, _ = storage.readRecord(relocationMap[offset])
+	if header == nil || header.version != 1 {
+		t.Logf("expected version 1 after compaction, got %v", header)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
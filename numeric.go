@@ -0,0 +1,707 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// a numeric field is indexed in two files:
+//
+// <field>.numeric is the magic and the biggest offset in the file (so
+// recovery does not have to read it to find entries pointing after the
+// end of append.raw) followed by [value 8 bytes][offset 8 bytes] entries
+// sorted by value, so a range is found with binary search
+//
+// <field>.numeric.tail is [merged 8 bytes] followed by the entries
+// appended since the last merge, in offset order; once the tail is big
+// enough it is merged in the sorted file. merged is how many entries
+// were in the sorted file when the tail was started, so if we crash
+// after the merge but before resetting the tail, the entries that are
+// already merged are skipped
+
+const numericEntryLen = 8 + 8
+const numericHeaderLen = 8 + 8
+const numericTailHeaderLen = 8
+
+// the tail is merged when it has that many entries, or a quarter of
+// the sorted file, whichever is bigger
+const numericMergeSize = 4096
+
+var numericMagic = []byte{'r', 'o', 'c', 'h', 'n', 'i', 2, 0xff}
+
+// written by older versions, without the biggest offset
+var numericMagicWithoutMaxOffset = []byte{'r', 'o', 'c', 'h', 'n', 'i', 1, 0xff}
+
+type numericEntry struct {
+	value  float64
+	offset int64
+}
+
+type NumericIndex struct {
+	descriptor *os.File
+	tail       *os.File
+	path       string
+	// number of entries in the sorted file
+	sorted uint64
+	// the biggest offset in the sorted file
+	maxOffset uint64
+	// entries in the tail
+	pending []numericEntry
+	dirty   uint32
+	sync.Mutex
+}
+
+// the bounds of a range query, by default they are inclusive and
+// infinite
+type numericRange struct {
+	min          float64
+	max          float64
+	minExclusive bool
+	maxExclusive bool
+}
+
+func newNumericRange() numericRange {
+	return numericRange{min: math.Inf(-1), max: math.Inf(1)}
+}
+
+func (this numericRange) aboveMin(value float64) bool {
+	if this.minExclusive {
+		return value > this.min
+	}
+	return value >= this.min
+}
+
+func (this numericRange) belowMax(value float64) bool {
+	if this.maxExclusive {
+		return value < this.max
+	}
+	return value <= this.max
+}
+
+func encodeNumericEntry(b []byte, e numericEntry) {
+	binary.LittleEndian.PutUint64(b, math.Float64bits(e.value))
+	binary.LittleEndian.PutUint64(b[8:], uint64(e.offset))
+}
+
+func decodeNumericEntry(b []byte) numericEntry {
+	return numericEntry{
+		value:  math.Float64frombits(binary.LittleEndian.Uint64(b)),
+		offset: int64(binary.LittleEndian.Uint64(b[8:])),
+	}
+}
+
+func decodeNumericEntries(data []byte) []numericEntry {
+	entries := make([]numericEntry, len(data)/numericEntryLen)
+	for i := range entries {
+		entries[i] = decodeNumericEntry(data[i*numericEntryLen:])
+	}
+	return entries
+}
+
+func sortNumericEntries(entries []numericEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].value != entries[j].value {
+			return entries[i].value < entries[j].value
+		}
+		return entries[i].offset < entries[j].offset
+	})
+}
+
+func openNumericIndex(indexPath string) (*NumericIndex, error) {
+	tail, _ := openAtEnd(indexPath + ".tail")
+	f, size := openAtEnd(indexPath)
+	n := &NumericIndex{
+		descriptor: f,
+		tail:       tail,
+		path:       indexPath,
+	}
+
+	err := n.load(size)
+	if err != nil {
+		n.close()
+		return nil, err
+	}
+	return n, nil
+}
+
+func (this *NumericIndex) load(size uint64) error {
+	if size == 0 {
+		_, err := this.descriptor.WriteAt(encodeSorted(nil), 0)
+		if err != nil {
+			return err
+		}
+		size = numericHeaderLen
+	}
+
+	header := make([]byte, numericHeaderLen)
+	_, err := this.descriptor.ReadAt(header[:len(numericMagic)], 0)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(header[:len(numericMagic)], numericMagicWithoutMaxOffset) {
+		size, err = this.upgrade(size)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = this.descriptor.ReadAt(header, 0)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(header[:len(numericMagic)], numericMagic) {
+		return fmt.Errorf("%s is not a numeric index", this.path)
+	}
+	this.maxOffset = binary.LittleEndian.Uint64(header[len(numericMagic):])
+
+	entries := size - numericHeaderLen
+	if entries%numericEntryLen != 0 {
+		// the sorted file is only replaced with rename
+		return fmt.Errorf("%s has a partial entry", this.path)
+	}
+	this.sorted = entries / numericEntryLen
+
+	fi, err := this.tail.Stat()
+	if err != nil {
+		return err
+	}
+	data, err := readAll(this.tail, fi.Size())
+	if err != nil {
+		return err
+	}
+	if len(data) < numericTailHeaderLen {
+		return this.resetTail()
+	}
+
+	started := binary.LittleEndian.Uint64(data)
+	data = data[numericTailHeaderLen:]
+	pending := decodeNumericEntries(data)
+	if started < this.sorted {
+		skip := this.sorted - started
+		if skip > uint64(len(pending)) {
+			skip = uint64(len(pending))
+		}
+		log.Printf("%s recovery: %d entries in the tail are already merged", this.path, skip)
+		pending = pending[skip:]
+	}
+	this.pending = pending
+
+	if started != this.sorted || len(data)%numericEntryLen != 0 {
+		// merge everything, so the tail is rewritten safely
+		return this.rewrite(nil)
+	}
+	return nil
+}
+
+// rewrites a sorted file of an older version with the biggest offset,
+// the entries stay the same so the tail is still valid; returns the new
+// size
+func (this *NumericIndex) upgrade(size uint64) (uint64, error) {
+	data, err := readAll(this.descriptor, int64(size))
+	if err != nil {
+		return 0, err
+	}
+	data = data[len(numericMagicWithoutMaxOffset):]
+	if len(data)%numericEntryLen != 0 {
+		return 0, fmt.Errorf("%s has a partial entry", this.path)
+	}
+	f, err := this.writeSorted(decodeNumericEntries(data))
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("%s converted to the current format", this.path)
+	this.descriptor.Close()
+	this.descriptor = f
+	return numericHeaderLen + uint64(len(data)), nil
+}
+
+// empties the tail after its entries were merged, the header is
+// written after the truncate, so a crash in between leaves an empty
+// tail with an old header, which is harmless
+func (this *NumericIndex) resetTail() error {
+	err := this.tail.Truncate(numericTailHeaderLen)
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, numericTailHeaderLen)
+	binary.LittleEndian.PutUint64(header, this.sorted)
+	_, err = this.tail.WriteAt(header, 0)
+	return err
+}
+
+// reads the sorted file, must be called with the lock held
+func (this *NumericIndex) readSorted() ([]numericEntry, error) {
+	data := make([]byte, this.sorted*numericEntryLen)
+	_, err := this.descriptor.ReadAt(data, numericHeaderLen)
+	if err != nil && this.sorted > 0 {
+		return nil, err
+	}
+	return decodeNumericEntries(data), nil
+}
+
+// returns the magic and the biggest offset followed by the entries
+func encodeSorted(entries []numericEntry) []byte {
+	data := make([]byte, numericHeaderLen+len(entries)*numericEntryLen)
+	copy(data, numericMagic)
+	maxOffset := uint64(0)
+	for i, e := range entries {
+		encodeNumericEntry(data[numericHeaderLen+i*numericEntryLen:], e)
+		if uint64(e.offset) > maxOffset {
+			maxOffset = uint64(e.offset)
+		}
+	}
+	binary.LittleEndian.PutUint64(data[len(numericMagic):], maxOffset)
+	return data
+}
+
+// writes the sorted file with rename, and returns it opened
+func (this *NumericIndex) writeSorted(entries []numericEntry) (*os.File, error) {
+	tmpPath := this.path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	_, err = f.WriteAt(encodeSorted(entries), 0)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, this.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return nil, err
+	}
+	return f, nil
+}
+
+// replaces the sorted file with the sorted entries and the pending
+// ones, the caller must hold the lock; with nil the current entries are
+// merged with the pending ones
+func (this *NumericIndex) rewrite(entries []numericEntry) error {
+	if entries == nil {
+		var err error
+		entries, err = this.readSorted()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, this.pending...)
+		sortNumericEntries(entries)
+	}
+
+	f, err := this.writeSorted(entries)
+	if err != nil {
+		return err
+	}
+
+	this.descriptor.Close()
+	this.descriptor = f
+	this.sorted = uint64(len(entries))
+	this.maxOffset = 0
+	for _, e := range entries {
+		if uint64(e.offset) > this.maxOffset {
+			this.maxOffset = uint64(e.offset)
+		}
+	}
+	this.pending = nil
+	return this.resetTail()
+}
+
+func (this *NumericIndex) append(value float64, offset uint64) {
+	this.Lock()
+	defer this.Unlock()
+
+	e := numericEntry{value: value, offset: int64(offset)}
+	data := make([]byte, numericEntryLen)
+	encodeNumericEntry(data, e)
+
+	this.tail.WriteAt(data, int64(numericTailHeaderLen+len(this.pending)*numericEntryLen))
+	this.pending = append(this.pending, e)
+	atomic.StoreUint32(&this.dirty, 1)
+
+	if len(this.pending) >= numericMergeSize && uint64(len(this.pending)) >= this.sorted/4 {
+		err := this.rewrite(nil)
+		if err != nil {
+			log.Printf("%s failed to merge, keeping the entries in the tail, err: %s", this.path, err.Error())
+		}
+	}
+}
+
+// returns the position in the sorted file of the first entry in the
+// range and of the first one after it, found with binary search; must
+// be called with the lock held
+func (this *NumericIndex) bounds(r numericRange) (int, int, error) {
+	var err error
+	value := func(i int) float64 {
+		b := make([]byte, 8)
+		_, readErr := this.descriptor.ReadAt(b, int64(numericHeaderLen+i*numericEntryLen))
+		if readErr != nil {
+			err = readErr
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	start := sort.Search(int(this.sorted), func(i int) bool {
+		return r.aboveMin(value(i))
+	})
+	end := start + sort.Search(int(this.sorted)-start, func(i int) bool {
+		return !r.belowMax(value(start + i))
+	})
+	return start, end, err
+}
+
+// returns the number of entries in the range without reading them
+func (this *NumericIndex) count(r numericRange) (int64, error) {
+	this.Lock()
+	defer this.Unlock()
+
+	start, end, err := this.bounds(r)
+	if err != nil {
+		return 0, err
+	}
+	count := int64(end - start)
+	for _, e := range this.pending {
+		if r.aboveMin(e.value) && r.belowMax(e.value) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// returns the sorted offsets of the entries in the range
+func (this *NumericIndex) find(r numericRange) ([]int64, error) {
+	this.Lock()
+	defer this.Unlock()
+
+	start, end, err := this.bounds(r)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make([]int64, 0, end-start)
+	chunk := make([]byte, 4096*numericEntryLen)
+	for i := start; i < end; {
+		n := end - i
+		if n > 4096 {
+			n = 4096
+		}
+		_, err = this.descriptor.ReadAt(chunk[:n*numericEntryLen], int64(numericHeaderLen+i*numericEntryLen))
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range decodeNumericEntries(chunk[:n*numericEntryLen]) {
+			offsets = append(offsets, e.offset)
+		}
+		i += n
+	}
+
+	for _, e := range this.pending {
+		if r.aboveMin(e.value) && r.belowMax(e.value) {
+			offsets = append(offsets, e.offset)
+		}
+	}
+	return sortedUnique(offsets), nil
+}
+
+// iterates over the offsets of the entries in a range; the entries are
+// sorted by value, so their offsets have to be sorted before the first
+// one is returned, they are read only when the iteration starts, the
+// query is planned with the number of entries in the range, and it is
+// not read at all if the query finishes before getting to it
+type rangeQuery struct {
+	field    string
+	index    *NumericIndex
+	r        numericRange
+	size     int64
+	reversed bool
+	term     *Term
+	err      error
+	QueryBase
+}
+
+func (q *rangeQuery) load() *Term {
+	if q.term == nil {
+		offsets, err := q.index.find(q.r)
+		if err != nil {
+			q.err = err
+		}
+		q.term = NewTerm(offsets)
+		if q.reversed {
+			q.term.reverse()
+		}
+	}
+	return q.term
+}
+
+func (q *rangeQuery) advance(target int64) int64 {
+	q.docId = q.load().advance(target)
+	return q.docId
+}
+
+func (q *rangeQuery) Next() int64 {
+	q.docId = q.load().Next()
+	return q.docId
+}
+
+func (q *rangeQuery) reverse() {
+	q.reversed = true
+}
+
+func (q *rangeQuery) Err() error {
+	if q.err != nil {
+		return q.err
+	}
+	if q.term != nil {
+		return q.term.Err()
+	}
+	return nil
+}
+
+func (q *rangeQuery) cost() int64 {
+	return q.size
+}
+
+// writes the index relocated with the relocation map produced by
+// compact() next to the current files, they are renamed over them by
+// swapCompacted(); entries of records that did not survive the
+// compaction are dropped
+func (this *NumericIndex) stageRelocation(relocationMap map[uint64]uint64) ([]string, error) {
+	this.Lock()
+	entries, err := this.readSorted()
+	entries = append(entries, this.pending...)
+	this.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	relocated := make([]numericEntry, 0, len(entries))
+	for _, e := range entries {
+		if newOffset, ok := relocationMap[uint64(e.offset)]; ok {
+			relocated = append(relocated, numericEntry{value: e.value, offset: int64(newOffset)})
+		}
+	}
+	sortNumericEntries(relocated)
+
+	files := []string{this.path, this.path + ".tail"}
+	err = stageFile(this.path, encodeSorted(relocated))
+	if err == nil {
+		header := make([]byte, numericTailHeaderLen)
+		binary.LittleEndian.PutUint64(header, uint64(len(relocated)))
+		err = stageFile(this.path+".tail", header)
+	}
+	return files, err
+}
+
+// reads the files again after they were replaced by swapCompacted()
+func (this *NumericIndex) reopen() error {
+	this.Lock()
+	defer this.Unlock()
+
+	this.close()
+	this.tail, _ = openAtEnd(this.path + ".tail")
+	f, size := openAtEnd(this.path)
+	this.descriptor = f
+	this.sorted = 0
+	this.pending = nil
+	return this.load(size)
+}
+
+// drops the entries pointing after the last valid record, the sorted
+// file is read only if its biggest offset is after it
+func (this *NumericIndex) recover(end uint64) {
+	if this.before(end) {
+		return
+	}
+
+	dropped := 0
+	err := this.filter(func(e numericEntry) (int64, bool) {
+		if e.offset < 0 || uint64(e.offset) >= end {
+			dropped++
+			return 0, false
+		}
+		return e.offset, true
+	})
+	if err != nil {
+		log.Printf("%s recovery: failed to rewrite, err: %s", this.path, err.Error())
+	}
+	if dropped > 0 {
+		log.Printf("%s recovery: dropped %d entries pointing after %d", this.path, dropped, end)
+	}
+}
+
+// true if all the entries point before end
+func (this *NumericIndex) before(end uint64) bool {
+	this.Lock()
+	defer this.Unlock()
+
+	if this.sorted > 0 && this.maxOffset >= end {
+		return false
+	}
+	for _, e := range this.pending {
+		if e.offset < 0 || uint64(e.offset) >= end {
+			return false
+		}
+	}
+	return true
+}
+
+// rewrites the index with the entries accepted by keep, with their
+// offset replaced
+func (this *NumericIndex) filter(keep func(numericEntry) (int64, bool)) error {
+	this.Lock()
+	defer this.Unlock()
+
+	entries, err := this.readSorted()
+	if err != nil {
+		return err
+	}
+	entries = append(entries, this.pending...)
+
+	kept := make([]numericEntry, 0, len(entries))
+	changed := false
+	for _, e := range entries {
+		offset, ok := keep(e)
+		if !ok || offset != e.offset {
+			changed = true
+		}
+		if ok {
+			kept = append(kept, numericEntry{value: e.value, offset: offset})
+		}
+	}
+	if !changed {
+		return nil
+	}
+	sortNumericEntries(kept)
+	return this.rewrite(kept)
+}
+
+func (this *NumericIndex) size() uint64 {
+	this.Lock()
+	defer this.Unlock()
+	return this.sorted + uint64(len(this.pending))
+}
+
+func (this *NumericIndex) sync() error {
+	err := this.descriptor.Sync()
+	if err != nil {
+		return err
+	}
+	return this.tail.Sync()
+}
+
+func (this *NumericIndex) close() {
+	this.descriptor.Close()
+	this.tail.Close()
+}
+
+func (this *StoreItem) GetNumericIndex(name string) *NumericIndex {
+	name = sanitize(name)
+	this.RLock()
+	defer this.RUnlock()
+
+	return this.fields[name]
+}
+
+func (this *StoreItem) CreateNumericIndex(name string) *NumericIndex {
+	name = sanitize(name)
+	this.RLock()
+	if n, ok := this.fields[name]; ok {
+		this.RUnlock()
+		return n
+	}
+	this.RUnlock()
+	this.Lock()
+	defer this.Unlock()
+
+	if n, ok := this.fields[name]; ok {
+		return n
+	}
+
+	n, err := openNumericIndex(path.Join(this.root, fmt.Sprintf("%s.numeric", name)))
+	if err != nil {
+		panic(err)
+	}
+	this.fields[name] = n
+	return n
+}
+
+var emptyFieldNameError = &invalidInputError{"field name can not be empty"}
+
+func validateFields(fields map[string]float64) error {
+	for name, value := range fields {
+		if sanitize(name) == "" {
+			return emptyFieldNameError
+		}
+		if math.IsNaN(value) {
+			return &invalidInputError{fmt.Sprintf("field %s is NaN", name)}
+		}
+	}
+	return nil
+}
+
+/*
+
+{"range": {"field": "latency", "gte": 500, "lt": 1000}}
+
+*/
+
+func rangeFromJSON(store *StoreItem, input interface{}) (Query, error) {
+	mapped, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("[range] must be an object with field and gt, gte, lt or lte")
+	}
+
+	field, ok := mapped["field"].(string)
+	if !ok || sanitize(field) == "" {
+		return nil, errors.New("[range] needs a field")
+	}
+
+	r := newNumericRange()
+	for key, v := range mapped {
+		if key == "field" {
+			continue
+		}
+		value, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("[range] %s must be a number", key)
+		}
+		switch key {
+		case "gt":
+			r.min, r.minExclusive = value, true
+		case "gte":
+			r.min, r.minExclusive = value, false
+		case "lt":
+			r.max, r.maxExclusive = value, true
+		case "lte":
+			r.max, r.maxExclusive = value, false
+		default:
+			return nil, fmt.Errorf("[range] unknown bound %s, expected gt, gte, lt or lte", key)
+		}
+	}
+
+	index := store.GetNumericIndex(field)
+	if index == nil {
+		return NewTerm([]int64{}), nil
+	}
+	size, err := index.count(r)
+	if err != nil {
+		return nil, err
+	}
+	return &rangeQuery{
+		field:     strings.TrimSuffix(path.Base(index.path), ".numeric"),
+		index:     index,
+		r:         r,
+		size:      size,
+		QueryBase: QueryBase{NOT_READY},
+	}, nil
+}
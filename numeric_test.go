@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"testing"
+)
+
+func TestRangeQuery(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_range_test")
+	os.RemoveAll(root)
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+
+	type record struct {
+		latency float64
+		even    bool
+	}
+	records := map[int64]record{}
+	for i := 0; i < numericMergeSize+500; i++ {
+		r := record{latency: float64((i*7919)%1000) - 100.5, even: i%2 == 0}
+		tags := []string{}
+		if r.even {
+			tags = append(tags, "even")
+		}
+		offset, err := storage.appendItem(&Append{Data: []byte("x"), Tags: tags, Fields: map[string]float64{"latency": r.latency}})
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		records[int64(offset)] = r
+	}
+	n := storage.GetNumericIndex("latency")
+	if n.sorted != numericMergeSize || len(n.pending) != 500 {
+		t.Logf("expected the tail to be merged, sorted %d pending %d", n.sorted, len(n.pending))
+		t.FailNow()
+	}
+
+	expect := func(r numericRange, even bool) []int64 {
+		out := []int64{}
+		for offset, record := range records {
+			if r.aboveMin(record.latency) && r.belowMax(record.latency) && (record.even || !even) {
+				out = append(out, offset)
+			}
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+		return out
+	}
+	run := func(input string) []int64 {
+		var decoded interface{}
+		json.Unmarshal([]byte(input), &decoded)
+		q, err := fromJSON(storage, decoded)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		return query(q)
+	}
+	check := func() {
+		eq(t, expect(numericRange{min: 399.5, max: 409.5, maxExclusive: true}, false), run(`{"range":{"field":"latency","gte":399.5,"lt":409.5}}`))
+		eq(t, expect(numericRange{min: 399.5, max: 409.5, minExclusive: true}, false), run(`{"range":{"field":"latency","gt":399.5,"lte":409.5}}`))
+		eq(t, expect(numericRange{min: 800, max: math.Inf(1)}, true), run(`{"and":[{"tag":"even"},{"range":{"field":"latency","gte":800}}]}`))
+		eq(t, expect(numericRange{min: math.Inf(-1), max: -90, maxExclusive: true}, false), run(`{"range":{"field":"latency","lt":-90}}`))
+		eq(t, []int64{}, run(`{"range":{"field":"missing","gte":0}}`))
+	}
+	check()
+
+	// the range is counted without reading it, and read when the
+	// iteration starts
+	var decoded interface{}
+	json.Unmarshal([]byte(`{"field":"latency","gte":800}`), &decoded)
+	q, err := rangeFromJSON(storage, decoded)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	matching := expect(numericRange{min: 800, max: math.Inf(1)}, false)
+	if r := q.(*rangeQuery); r.term != nil || r.cost() != int64(len(matching)) {
+		t.Logf("expected cost %d without reading the range, got %d", len(matching), r.cost())
+		t.FailNow()
+	}
+	out, err := storage.explainQuery(q, QueryOptions{descending: true, limit: 10})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if out.Query.Type != "range" || out.Query.Tag != "latency" || out.Query.Cost != int64(len(matching)) || out.Matches != 10 {
+		t.Logf("unexpected explain %v", out)
+		t.FailNow()
+	}
+	descending := []int64{}
+	q, _ = rangeFromJSON(storage, decoded)
+	storage.ExecuteQuery(q, QueryOptions{descending: true, limit: 10}, func(offset uint64, header *Header, data []byte) bool {
+		descending = append(descending, int64(offset))
+		return true
+	})
+	for i := range descending {
+		if descending[i] != matching[len(matching)-1-i] {
+			t.Logf("expected %d, got %d", matching[len(matching)-1-i], descending[i])
+			t.FailNow()
+		}
+	}
+
+	for _, input := range []string{`{"range":{"gte":1}}`, `{"range":{"field":"latency","gte":"1"}}`, `{"range":{"field":"latency","above":1}}`} {
+		var decoded interface{}
+		json.Unmarshal([]byte(input), &decoded)
+		_, err := fromJSON(storage, decoded)
+		if err == nil {
+			t.Logf("expected error for %s", input)
+			t.FailNow()
+		}
+	}
+	_, err = storage.appendItem(&Append{Data: []byte("x"), Fields: map[string]float64{"latency": math.NaN()}})
+	if err == nil {
+		t.Log("expected error for NaN")
+		t.FailNow()
+	}
+
+	// relocated by compaction
+	for offset := range records {
+		if offset%3 == 0 {
+			storage.deleteRecord(uint64(offset))
+		}
+	}
+	relocationMap, err := storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	relocated := map[int64]record{}
+	for offset, r := range records {
+		if newOffset, ok := relocationMap[uint64(offset)]; ok {
+			relocated[int64(newOffset)] = r
+		}
+	}
+	records = relocated
+	check()
+
+	// reopened with entries in the tail and entries after the end
+	offset, _ := storage.appendItem(&Append{Data: []byte("x"), Fields: map[string]float64{"latency": 1}})
+	records[int64(offset)] = record{latency: 1}
+	n = storage.GetNumericIndex("latency")
+	n.append(1, storage.offset)
+	storage.descriptor.Close()
+	for _, p := range storage.index {
+		p.close()
+	}
+	n.close()
+	storage = NewStorage(root, Durability{})
+	defer storage.close()
+	eq(t, expect(numericRange{min: 1, max: 1}, false), run(`{"range":{"field":"latency","gte":1,"lte":1}}`))
+
+	// not rewritten when the entries point before the end
+	offset, _ = storage.appendItem(&Append{Data: []byte("x"), Fields: map[string]float64{"latency": 1}})
+	records[int64(offset)] = record{latency: 1}
+	sorted, _ := storage.GetNumericIndex("latency").descriptor.Stat()
+	storage.close()
+	storage = NewStorage(root, Durability{})
+	defer storage.close()
+	reopened, _ := storage.GetNumericIndex("latency").descriptor.Stat()
+	if !os.SameFile(sorted, reopened) || len(storage.GetNumericIndex("latency").pending) != 1 {
+		t.Log("expected the index to be opened without rewriting it")
+		t.FailNow()
+	}
+	eq(t, expect(numericRange{min: 1, max: 1}, false), run(`{"range":{"field":"latency","gte":1,"lte":1}}`))
+	os.RemoveAll(root)
+}
+
+func TestNumericUpgrade(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_numeric_upgrade_test")
+	os.RemoveAll(root)
+	os.MkdirAll(root, 0700)
+	indexPath := path.Join(root, "latency.numeric")
+
+	// sorted file without the biggest offset, and one entry in the tail
+	data := append([]byte{}, numericMagicWithoutMaxOffset...)
+	for _, e := range []numericEntry{{value: 1, offset: 200}, {value: 2, offset: 100}} {
+		b := make([]byte, numericEntryLen)
+		encodeNumericEntry(b, e)
+		data = append(data, b...)
+	}
+	ioutil.WriteFile(indexPath, data, 0600)
+	tail := make([]byte, numericTailHeaderLen+numericEntryLen)
+	binary.LittleEndian.PutUint64(tail, 2)
+	encodeNumericEntry(tail[numericTailHeaderLen:], numericEntry{value: 3, offset: 300})
+	ioutil.WriteFile(indexPath+".tail", tail, 0600)
+
+	n, err := openNumericIndex(indexPath)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	defer n.close()
+	if n.maxOffset != 200 || n.sorted != 2 || len(n.pending) != 1 {
+		t.Logf("unexpected index after the upgrade, max offset %d sorted %d pending %d", n.maxOffset, n.sorted, len(n.pending))
+		t.FailNow()
+	}
+	offsets, _ := n.find(newNumericRange())
+	eq(t, []int64{100, 200, 300}, offsets)
+	upgraded, _ := ioutil.ReadFile(indexPath)
+	if !bytes.Equal(upgraded[:len(numericMagic)], numericMagic) {
+		t.Log("expected the current magic")
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
@@ -0,0 +1,651 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// a postings list is stored in two files:
+//
+// <tag>.postings is the magic and the generation of the file (8 bytes)
+// followed by compressed blocks of up to postingsBlockSize postings:
+//   [count 4 bytes][payload length 4 bytes][first 8 bytes][last 8 bytes][crc 4 bytes]payload
+// the payload has the varint encoded deltas between the postings after
+// the first one. first and last are the skip data, so queries can jump
+// over a block without decoding it
+//
+// <tag>.postings.tail is [sealed 8 bytes][generation 8 bytes] followed
+// by raw 8 byte postings that are not in a block yet; sealed is how many
+// postings were in the blocks when the tail was started, so if we crash
+// after writing a block but before resetting the tail, the postings
+// that are already in the block are skipped. the generation is the one
+// of the postings file the tail was started for, when the postings file
+// is rewritten (with the pending postings in it) the generation is
+// incremented, so if we crash before resetting the tail, the stale tail
+// is dropped
+//
+// only the last block is checked when the postings list is opened, as
+// only the last one can be torn by a crash
+//
+// postings files written before the compressed format are just raw 8
+// byte postings, they are converted when opened; the first version of
+// the compressed format had no generation, they are rewritten when
+// opened
+
+const postingsBlockSize = 128
+const postingsBlockHeaderLen = 4 + 4 + 8 + 8 + 4
+const postingsHeaderLen = 8 + 8
+const postingsTailHeaderLen = 8 + 8
+
+// the last byte makes it an offset after 2^56 if it is read as a raw
+// posting, so it can not be confused with the old format
+var postingsMagic = []byte{'r', 'o', 'c', 'h', 'p', 'l', 2, 0xff}
+var postingsMagicWithoutGeneration = []byte{'r', 'o', 'c', 'h', 'p', 'l', 1, 0xff}
+
+var corruptedPostingsError = errors.New("corrupted postings block")
+
+type postingsBlock struct {
+	// of the payload in the postings file
+	offset int64
+	length int
+	count  int
+	first  int64
+	last   int64
+}
+
+type PostingsList struct {
+	descriptor *os.File
+	tail       *os.File
+	path       string
+	// end of the last block
+	offset uint64
+	blocks []postingsBlock
+	// number of postings in the blocks
+	sealed uint64
+	// of the postings file, the tail must have the same
+	generation uint64
+	// postings in the tail
+	pending []int64
+	dirty   uint32
+	sync.Mutex
+}
+
+func openPostingsList(postingsPath string) (*PostingsList, error) {
+	tail, _ := openAtEnd(postingsPath + ".tail")
+	f, size := openAtEnd(postingsPath)
+	p := &PostingsList{
+		descriptor: f,
+		tail:       tail,
+		path:       postingsPath,
+	}
+
+	err := p.load(size)
+	if err != nil {
+		p.close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func blockChecksum(encoded []byte) uint32 {
+	b := make([]byte, 0, len(encoded)-4)
+	b = append(b, encoded[:postingsBlockHeaderLen-4]...)
+	b = append(b, encoded[postingsBlockHeaderLen:]...)
+	return crc(b)
+}
+
+func encodeBlock(postings []int64) []byte {
+	payload := make([]byte, 0, len(postings)*2)
+	varint := make([]byte, binary.MaxVarintLen64)
+	for i := 1; i < len(postings); i++ {
+		n := binary.PutVarint(varint, postings[i]-postings[i-1])
+		payload = append(payload, varint[:n]...)
+	}
+
+	encoded := make([]byte, postingsBlockHeaderLen+len(payload))
+	binary.LittleEndian.PutUint32(encoded[0:], uint32(len(postings)))
+	binary.LittleEndian.PutUint32(encoded[4:], uint32(len(payload)))
+	binary.LittleEndian.PutUint64(encoded[8:], uint64(postings[0]))
+	binary.LittleEndian.PutUint64(encoded[16:], uint64(postings[len(postings)-1]))
+	copy(encoded[postingsBlockHeaderLen:], payload)
+	binary.LittleEndian.PutUint32(encoded[24:], blockChecksum(encoded))
+	return encoded
+}
+
+// appends the postings of a block to dst
+func decodePostings(dst []int64, first int64, count int, payload []byte) ([]int64, error) {
+	current := first
+	dst = append(dst, current)
+	for i := 1; i < count; i++ {
+		delta, n := binary.Varint(payload)
+		if n <= 0 {
+			return nil, corruptedPostingsError
+		}
+		current += delta
+		dst = append(dst, current)
+		payload = payload[n:]
+	}
+	return dst, nil
+}
+
+func decodeBlockHeader(header []byte, offset int64) postingsBlock {
+	return postingsBlock{
+		offset: offset + postingsBlockHeaderLen,
+		count:  int(binary.LittleEndian.Uint32(header[0:])),
+		length: int(binary.LittleEndian.Uint32(header[4:])),
+		first:  int64(binary.LittleEndian.Uint64(header[8:])),
+		last:   int64(binary.LittleEndian.Uint64(header[16:])),
+	}
+}
+
+// returns the block starting at offset in data, or an error if it is
+// torn or corrupted
+func readBlock(data []byte, offset int) (postingsBlock, error) {
+	if offset+postingsBlockHeaderLen > len(data) {
+		return postingsBlock{}, corruptedPostingsError
+	}
+	block := decodeBlockHeader(data[offset:], int64(offset))
+	end := offset + postingsBlockHeaderLen + block.length
+	if block.count == 0 || end > len(data) {
+		return postingsBlock{}, corruptedPostingsError
+	}
+	if binary.LittleEndian.Uint32(data[offset+24:]) != blockChecksum(data[offset:end]) {
+		return postingsBlock{}, corruptedPostingsError
+	}
+	return block, nil
+}
+
+func readAll(f *os.File, size int64) ([]byte, error) {
+	data := make([]byte, size)
+	n, err := f.ReadAt(data, 0)
+	if n != len(data) && err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (this *PostingsList) load(size uint64) error {
+	if size == 0 {
+		_, err := this.descriptor.WriteAt(encodePostingsHeader(0), 0)
+		if err != nil {
+			return err
+		}
+		size = postingsHeaderLen
+	}
+
+	header := make([]byte, postingsHeaderLen)
+	n, _ := this.descriptor.ReadAt(header, 0)
+	start := int64(postingsHeaderLen)
+	switch {
+	case n == postingsHeaderLen && bytes.HasPrefix(header, postingsMagic):
+		this.generation = binary.LittleEndian.Uint64(header[len(postingsMagic):])
+	case n >= len(postingsMagicWithoutGeneration) && bytes.HasPrefix(header, postingsMagicWithoutGeneration):
+		start = int64(len(postingsMagicWithoutGeneration))
+	default:
+		data, err := readAll(this.descriptor, int64(size))
+		if err != nil {
+			return err
+		}
+		return this.migrate(data)
+	}
+
+	offset := start
+	for offset < int64(size) {
+		block, err := this.readBlockAt(offset, int64(size))
+		if err != nil {
+			log.Printf("%s recovery: torn block at %d, truncating from %d", this.path, offset, size)
+			err = this.descriptor.Truncate(offset)
+			if err != nil {
+				return err
+			}
+			break
+		}
+		this.blocks = append(this.blocks, block)
+		this.sealed += uint64(block.count)
+		offset = block.offset + int64(block.length)
+	}
+	this.offset = uint64(offset)
+
+	if start != postingsHeaderLen {
+		return this.upgrade()
+	}
+	return this.loadTail()
+}
+
+// reads the header of the block at offset, the whole block is checked
+// only if it is the last one, as a crash can only tear the last block
+func (this *PostingsList) readBlockAt(offset, size int64) (postingsBlock, error) {
+	if offset+postingsBlockHeaderLen > size {
+		return postingsBlock{}, corruptedPostingsError
+	}
+	header := make([]byte, postingsBlockHeaderLen)
+	_, err := this.descriptor.ReadAt(header, offset)
+	if err != nil {
+		return postingsBlock{}, err
+	}
+	block := decodeBlockHeader(header, offset)
+	end := block.offset + int64(block.length)
+	if block.count == 0 || end > size {
+		return postingsBlock{}, corruptedPostingsError
+	}
+	if end < size {
+		return block, nil
+	}
+
+	encoded := make([]byte, end-offset)
+	_, err = this.descriptor.ReadAt(encoded, offset)
+	if err != nil {
+		return postingsBlock{}, err
+	}
+	_, err = readBlock(encoded, 0)
+	return block, err
+}
+
+func (this *PostingsList) loadTail() error {
+	fi, err := this.tail.Stat()
+	if err != nil {
+		return err
+	}
+	data, err := readAll(this.tail, fi.Size())
+	if err != nil {
+		return err
+	}
+
+	if len(data) < postingsTailHeaderLen {
+		// new postings list, or a crash before the header was written
+		return this.resetTail()
+	}
+	started := binary.LittleEndian.Uint64(data)
+	generation := binary.LittleEndian.Uint64(data[8:])
+	if generation != this.generation {
+		log.Printf("%s recovery: dropping the tail of generation %d, the postings are at generation %d", this.path, generation, this.generation)
+		return this.resetTail()
+	}
+	data = data[postingsTailHeaderLen:]
+
+	pending := make([]int64, len(data)/8)
+	for i := range pending {
+		pending[i] = int64(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+
+	if started < this.sealed {
+		skip := this.sealed - started
+		if skip > uint64(len(pending)) {
+			skip = uint64(len(pending))
+		}
+		log.Printf("%s recovery: %d postings in the tail are already in a block", this.path, skip)
+		pending = pending[skip:]
+	}
+	if started > this.sealed {
+		log.Printf("%s recovery: %d postings were lost with a torn block", this.path, started-this.sealed)
+	}
+	this.pending = pending
+
+	if started != this.sealed || len(data)%8 != 0 {
+		return this.rewriteTail()
+	}
+	return nil
+}
+
+// rewrites a postings file of the first compressed format with a
+// generation, its tail is [sealed 8 bytes] followed by the postings
+func (this *PostingsList) upgrade() error {
+	fi, err := this.tail.Stat()
+	if err != nil {
+		return err
+	}
+	data, err := readAll(this.tail, fi.Size())
+	if err != nil {
+		return err
+	}
+	if len(data) >= 8 {
+		started := binary.LittleEndian.Uint64(data)
+		data = data[8:]
+		for i := 0; i+8 <= len(data); i += 8 {
+			if started < this.sealed {
+				started++
+				continue
+			}
+			this.pending = append(this.pending, int64(binary.LittleEndian.Uint64(data[i:])))
+		}
+	}
+
+	postings, err := this.readPostings()
+	if err != nil {
+		return err
+	}
+	log.Printf("%s converting %d postings to the format with generation", this.path, len(postings))
+	return this.rewrite(postings)
+}
+
+func encodePostingsHeader(generation uint64) []byte {
+	header := make([]byte, postingsHeaderLen)
+	copy(header, postingsMagic)
+	binary.LittleEndian.PutUint64(header[len(postingsMagic):], generation)
+	return header
+}
+
+func encodeTail(sealed, generation uint64, pending []int64) []byte {
+	data := make([]byte, postingsTailHeaderLen+len(pending)*8)
+	binary.LittleEndian.PutUint64(data, sealed)
+	binary.LittleEndian.PutUint64(data[8:], generation)
+	for i, p := range pending {
+		binary.LittleEndian.PutUint64(data[postingsTailHeaderLen+i*8:], uint64(p))
+	}
+	return data
+}
+
+// replaces the tail with the pending postings, the new tail is written
+// in a temporary file which is then renamed over the old one
+func (this *PostingsList) rewriteTail() error {
+	data := encodeTail(this.sealed, this.generation, this.pending)
+
+	tailPath := this.path + ".tail"
+	tmpPath := tailPath + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteAt(data, 0)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, tailPath)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	this.tail.Close()
+	this.tail = f
+	return nil
+}
+
+// empties the tail after the pending postings were sealed, the header
+// is written after the truncate, so a crash in between leaves an empty
+// tail with an old header, which is harmless
+func (this *PostingsList) resetTail() error {
+	err := this.tail.Truncate(postingsTailHeaderLen)
+	if err != nil {
+		return err
+	}
+
+	_, err = this.tail.WriteAt(encodeTail(this.sealed, this.generation, nil), 0)
+	return err
+}
+
+func (this *PostingsList) migrate(data []byte) error {
+	if len(data)%8 != 0 {
+		log.Printf("%s recovery: dropping partial entry of %d bytes", this.path, len(data)%8)
+		data = data[:len(data)-len(data)%8]
+	}
+
+	postings := make([]int64, len(data)/8)
+	for i := range postings {
+		postings[i] = int64(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+	log.Printf("%s converting %d postings to the compressed format", this.path, len(postings))
+	return this.rewrite(sortedUnique(postings))
+}
+
+func (this *PostingsList) list() ([]int64, error) {
+	this.Lock()
+	defer this.Unlock()
+	return this.readPostings()
+}
+
+// must be called with the lock held
+func (this *PostingsList) readPostings() ([]int64, error) {
+	data, err := readAll(this.descriptor, int64(this.offset))
+	if err != nil {
+		return nil, err
+	}
+
+	postings := make([]int64, 0, this.sealed+uint64(len(this.pending)))
+	for _, block := range this.blocks {
+		postings, err = decodePostings(postings, block.first, block.count, data[block.offset:block.offset+int64(block.length)])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s at %d", this.path, err.Error(), block.offset)
+		}
+	}
+	return append(postings, this.pending...), nil
+}
+
+// the term reads the blocks from the postings file while iterating,
+// if the file is replaced by a compaction the reads fail and the error
+// is returned by the query, as the offsets are not valid anymore
+func (this *PostingsList) newTermQuery() *Term {
+	this.Lock()
+	defer this.Unlock()
+
+	// blocks and pending are only appended to, so the term can keep
+	// using them while new postings are added
+	blocks := this.blocks[:len(this.blocks):len(this.blocks)]
+	pending := this.pending[:len(this.pending):len(this.pending)]
+	term := newBlockTerm(this.descriptor, blocks, pending)
+	term.tag = strings.TrimSuffix(path.Base(this.path), ".postings")
+	return term
+}
+
+func (this *PostingsList) size() uint64 {
+	this.Lock()
+	defer this.Unlock()
+	return this.sealed + uint64(len(this.pending))
+}
+
+// durable makes sure the block is on disk before the postings are
+// removed from the tail
+func (this *PostingsList) append(value uint64, durable bool) {
+	this.Lock()
+	defer this.Unlock()
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, value)
+
+	// add it to the end of the tail
+	this.tail.WriteAt(data, int64(postingsTailHeaderLen+len(this.pending)*8))
+	this.pending = append(this.pending, int64(value))
+	atomic.StoreUint32(&this.dirty, 1)
+
+	if len(this.pending) >= postingsBlockSize {
+		err := this.seal(durable)
+		if err != nil {
+			log.Printf("%s failed to write block, keeping the postings in the tail, err: %s", this.path, err.Error())
+		}
+	}
+}
+
+// moves the pending postings to a new block, must be called with the
+// lock held
+func (this *PostingsList) seal(durable bool) error {
+	encoded := encodeBlock(this.pending)
+	_, err := this.descriptor.WriteAt(encoded, int64(this.offset))
+	if err == nil && durable {
+		err = this.descriptor.Sync()
+	}
+	if err != nil {
+		this.descriptor.Truncate(int64(this.offset))
+		return err
+	}
+
+	block, _ := readBlock(encoded, 0)
+	block.offset += int64(this.offset)
+	this.blocks = append(this.blocks, block)
+	this.offset += uint64(len(encoded))
+	this.sealed += uint64(block.count)
+	this.pending = nil
+	return this.resetTail()
+}
+
+// queries expect strictly increasing postings
+func isSorted(postings []int64) bool {
+	for i := 1; i < len(postings); i++ {
+		if postings[i] <= postings[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedUnique(postings []int64) []int64 {
+	sorted := append([]int64{}, postings...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	unique := sorted[:0]
+	for i, p := range sorted {
+		if i == 0 || p != sorted[i-1] {
+			unique = append(unique, p)
+		}
+	}
+	return unique
+}
+
+// returns true if the postings are out of order or have duplicates,
+// with repair they are rewritten sorted; the caller must make sure
+// nothing is appended meanwhile
+func (this *PostingsList) verify(repair bool) (bool, error) {
+	postings, err := this.list()
+	if err != nil {
+		return false, err
+	}
+	if isSorted(postings) {
+		return false, nil
+	}
+	if !repair {
+		return true, nil
+	}
+	log.Printf("%s repairing unsorted postings", this.path)
+	return true, this.rewrite(sortedUnique(postings))
+}
+
+// writes the postings list relocated with the relocation map produced
+// by compact() next to the current files, they are renamed over them by
+// swapCompacted(); postings pointing to records that did not survive
+// the compaction are dropped
+func (this *PostingsList) stageRelocation(relocationMap map[uint64]uint64) ([]string, error) {
+	postings, err := this.list()
+	if err != nil {
+		return nil, err
+	}
+
+	relocated := make([]int64, 0, len(postings))
+	for _, offset := range postings {
+		if newOffset, ok := relocationMap[uint64(offset)]; ok {
+			relocated = append(relocated, int64(newOffset))
+		}
+	}
+
+	this.Lock()
+	generation := this.generation + 1
+	this.Unlock()
+	data, _ := encodeBlocks(generation, relocated)
+	files := []string{this.path, this.path + ".tail"}
+	err = stageFile(this.path, data)
+	if err == nil {
+		err = stageFile(this.path+".tail", encodeTail(uint64(len(relocated)), generation, nil))
+	}
+	return files, err
+}
+
+// reads the files again after they were replaced by swapCompacted()
+func (this *PostingsList) reopen() error {
+	p, err := openPostingsList(this.path)
+	if err != nil {
+		return err
+	}
+
+	this.Lock()
+	defer this.Unlock()
+	this.close()
+	this.descriptor = p.descriptor
+	this.tail = p.tail
+	this.offset = p.offset
+	this.blocks = p.blocks
+	this.sealed = p.sealed
+	this.generation = p.generation
+	this.pending = p.pending
+	return nil
+}
+
+// returns the header followed by the postings in blocks
+func encodeBlocks(generation uint64, postings []int64) ([]byte, []postingsBlock) {
+	data := encodePostingsHeader(generation)
+	blocks := []postingsBlock{}
+	for start := 0; start < len(postings); start += postingsBlockSize {
+		end := start + postingsBlockSize
+		if end > len(postings) {
+			end = len(postings)
+		}
+		encoded := encodeBlock(postings[start:end])
+		block, _ := readBlock(encoded, 0)
+		block.offset += int64(len(data))
+		blocks = append(blocks, block)
+		data = append(data, encoded...)
+	}
+	return data, blocks
+}
+
+// replaces the postings list with a new one, the new list is written
+// in a temporary file which is then renamed over the old one; the
+// postings must include the pending ones, as the tail is dropped
+func (this *PostingsList) rewrite(postings []int64) error {
+	this.Lock()
+	defer this.Unlock()
+
+	tmpPath := this.path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	generation := this.generation + 1
+	data, blocks := encodeBlocks(generation, postings)
+	_, err = f.WriteAt(data, 0)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, this.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	this.descriptor.Close()
+	this.descriptor = f
+	this.blocks = blocks
+	this.offset = uint64(len(data))
+	this.sealed = uint64(len(postings))
+	this.generation = generation
+	this.pending = nil
+	return this.resetTail()
+}
+
+func (this *PostingsList) sync() error {
+	err := this.descriptor.Sync()
+	if err != nil {
+		return err
+	}
+	return this.tail.Sync()
+}
+
+func (this *PostingsList) close() {
+	this.descriptor.Close()
+	this.tail.Close()
+}
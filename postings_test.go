@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestCompressedPostings(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_postings_test")
+	os.RemoveAll(root)
+	os.MkdirAll(root, 0700)
+	postingsPath := path.Join(root, "a.postings")
+
+	p, err := openPostingsList(postingsPath)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	expected := []int64{}
+	for i := 0; i < 1000; i++ {
+		offset := int64(i) * 100
+		p.append(uint64(offset), false)
+		expected = append(expected, offset)
+	}
+	if len(p.blocks) != 1000/postingsBlockSize || len(p.pending) != 1000%postingsBlockSize {
+		t.Logf("unexpected blocks %d pending %d", len(p.blocks), len(p.pending))
+		t.FailNow()
+	}
+	eq(t, expected, query(p.newTermQuery()))
+
+	fi, _ := p.descriptor.Stat()
+	if fi.Size() >= 1000*8/2 {
+		t.Logf("postings are not compressed, size %d", fi.Size())
+		t.FailNow()
+	}
+
+	// the tail is reset after the block is written, crash in between
+	tail, _ := ioutil.ReadFile(postingsPath + ".tail")
+	sealed := p.sealed
+	for i := 1000; i < 1000+postingsBlockSize-1000%postingsBlockSize; i++ {
+		p.append(uint64(i)*100, false)
+		expected = append(expected, int64(i)*100)
+	}
+	if len(p.pending) != 0 || p.sealed != sealed+postingsBlockSize {
+		t.Logf("expected a new block, pending %d", len(p.pending))
+		t.FailNow()
+	}
+	stale := append(tail, make([]byte, 8)...)
+	binary.LittleEndian.PutUint64(stale[len(tail):], uint64(1000*100))
+	p.close()
+	ioutil.WriteFile(postingsPath+".tail", stale, 0600)
+
+	p, err = openPostingsList(postingsPath)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	eq(t, expected, query(p.newTermQuery()))
+	list, _ := p.list()
+	eq(t, expected, list)
+
+	// skipping to a posting in the middle of a block
+	term := p.newTermQuery()
+	reads := &countingReader{source: term.source}
+	term.source = reads
+	if term.advance(50*100+1) != 51*100 || term.Next() != 52*100 || term.advance(900*100) != 900*100 {
+		t.Log("unexpected advance")
+		t.FailNow()
+	}
+	if reads.count != 2 {
+		t.Logf("expected only the first and the target block to be read, got %d reads", reads.count)
+		t.FailNow()
+	}
+
+	// read errors stop the iteration and are returned
+	term = p.newTermQuery()
+	term.source = &countingReader{source: term.source, fail: true}
+	if term.Next() != NO_MORE || term.Err() == nil {
+		t.Log("expected read error")
+		t.FailNow()
+	}
+	and := NewBoolAndQuery(NewTerm(expected), term)
+	if query(and); and.Err() == nil {
+		t.Log("expected read error from the and query")
+		t.FailNow()
+	}
+
+	for i := 0; i < 5; i++ {
+		offset := int64(2000+i) * 100
+		p.append(uint64(offset), false)
+		expected = append(expected, offset)
+	}
+	term = p.newTermQuery()
+	term.reverse()
+	reversed := append([]int64{}, expected...)
+	mirrorInPlace(reversed)
+	eq(t, reversed, query(term))
+	p.close()
+
+	// postings written before the compressed format
+	legacy := make([]byte, len(expected)*8+3)
+	for i, offset := range expected {
+		binary.LittleEndian.PutUint64(legacy[i*8:], uint64(offset))
+	}
+	os.Remove(postingsPath + ".tail")
+	ioutil.WriteFile(postingsPath, legacy, 0600)
+
+	p, err = openPostingsList(postingsPath)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	eq(t, expected, query(p.newTermQuery()))
+	p.close()
+
+	p, _ = openPostingsList(postingsPath)
+	eq(t, expected, query(p.newTermQuery()))
+	p.close()
+	os.RemoveAll(root)
+}
+
+type countingReader struct {
+	source io.ReaderAt
+	count  int
+	fail   bool
+}
+
+func (this *countingReader) ReadAt(p []byte, off int64) (int, error) {
+	this.count++
+	if this.fail {
+		return 0, errors.New("failed to read")
+	}
+	return this.source.ReadAt(p, off)
+}
+
+func TestSortedPostings(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_sorted_postings_test")
+	os.RemoveAll(root)
+
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+	done := make(chan bool)
+	for w := 0; w < 8; w++ {
+		go func() {
+			for i := 0; i < 500; i++ {
+				if i%2 == 0 {
+					storage.append(16, []byte("x"), "a", "b")
+				} else {
+					storage.append(16, []byte("x"))
+				}
+			}
+			done <- true
+		}()
+	}
+	for w := 0; w < 8; w++ {
+		<-done
+	}
+
+	out, err := storage.verifyPostings(false)
+	if err != nil || len(out.Unsorted) != 0 {
+		t.Logf("expected sorted postings, got %v %v", out, err)
+		t.FailNow()
+	}
+	a, _ := storage.GetPostingsList("a").list()
+	if len(a) != 8*250 {
+		t.Logf("expected %d postings, got %d", 8*250, len(a))
+		t.FailNow()
+	}
+
+	// written out of order by an older version
+	storage.CreatePostingsList("a").append(uint64(a[10]), false)
+	storage.CreatePostingsList("c").append(uint64(a[20]), false)
+	storage.CreatePostingsList("c").append(uint64(a[5]), false)
+
+	out, _ = storage.verifyPostings(false)
+	if len(out.Unsorted) != 2 || out.Unsorted[0] != "a" || out.Unsorted[1] != "c" {
+		t.Logf("expected a and c to be unsorted, got %v", out.Unsorted)
+		t.FailNow()
+	}
+
+	out, _ = storage.verifyPostings(true)
+	if len(out.Unsorted) != 2 || !out.Repaired {
+		t.Logf("expected a and c to be repaired, got %v", out)
+		t.FailNow()
+	}
+	eq(t, a, query(storage.GetPostingsList("a").newTermQuery()))
+	eq(t, []int64{a[5], a[20]}, query(storage.GetPostingsList("c").newTermQuery()))
+
+	// repaired when the namespace is opened
+	storage.CreatePostingsList("c").append(uint64(a[1]), false)
+	storage.descriptor.Close()
+	for _, p := range storage.index {
+		p.close()
+	}
+	storage = NewStorage(root, Durability{})
+	defer storage.close()
+	eq(t, []int64{a[1], a[5], a[20]}, query(storage.GetPostingsList("c").newTermQuery()))
+	os.RemoveAll(root)
+}
+
+func TestPostingsGeneration(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_postings_generation_test")
+	os.RemoveAll(root)
+	os.MkdirAll(root, 0700)
+	postingsPath := path.Join(root, "a.postings")
+
+	p, _ := openPostingsList(postingsPath)
+	for i := 0; i < 300; i++ {
+		p.append(uint64(i)*100, false)
+	}
+
+	// crash after the rewrite renamed the postings file, before the
+	// tail was reset
+	tail, _ := ioutil.ReadFile(postingsPath + ".tail")
+	err := p.rewrite(postingsList(10))
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	p.close()
+	ioutil.WriteFile(postingsPath+".tail", tail, 0600)
+
+	p, _ = openPostingsList(postingsPath)
+	list, _ := p.list()
+	eq(t, postingsList(10), list)
+	if p.generation != 1 || len(p.pending) != 0 {
+		t.Logf("expected generation 1 without pending postings, got %d %v", p.generation, p.pending)
+		t.FailNow()
+	}
+
+	// only the last block is checked when opened, the others are
+	// checked when a query reads them
+	for i := 10; i < 300; i++ {
+		p.append(uint64(i)*3, false)
+	}
+	p.close()
+	data, _ := ioutil.ReadFile(postingsPath)
+	data[postingsHeaderLen+postingsBlockHeaderLen] ^= 0xff
+	ioutil.WriteFile(postingsPath, data, 0600)
+	p, _ = openPostingsList(postingsPath)
+	if p.sealed != 266 {
+		t.Logf("expected 266 sealed postings, got %d", p.sealed)
+		t.FailNow()
+	}
+	term := p.newTermQuery()
+	if query(term); term.Err() == nil {
+		t.Log("expected the query to find the corrupted block")
+		t.FailNow()
+	}
+	p.close()
+
+	// the first compressed format had no generation, and only the
+	// sealed count in the tail
+	v1 := append([]byte{}, postingsMagicWithoutGeneration...)
+	v1 = append(v1, encodeBlock(postingsList(128))...)
+	v1 = append(v1, encodeBlock([]int64{1000, 1001})...)
+	v1Tail := make([]byte, 8+3*8)
+	binary.LittleEndian.PutUint64(v1Tail, 128)
+	for i, posting := range []uint64{1000, 1001, 1002} {
+		binary.LittleEndian.PutUint64(v1Tail[8+i*8:], posting)
+	}
+	ioutil.WriteFile(postingsPath, v1, 0600)
+	ioutil.WriteFile(postingsPath+".tail", v1Tail, 0600)
+
+	p, _ = openPostingsList(postingsPath)
+	defer p.close()
+	list, _ = p.list()
+	eq(t, append(postingsList(128), 1000, 1001, 1002), list)
+	data, _ = ioutil.ReadFile(postingsPath)
+	if !bytes.HasPrefix(data, postingsMagic) {
+		t.Log("expected the postings to be rewritten with a generation")
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
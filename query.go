@@ -2,7 +2,12 @@ package main
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"math"
+	"path"
+	"sort"
+	"strings"
 )
 
 const (
@@ -14,6 +19,18 @@ type Query interface {
 	advance(int64) int64
 	Next() int64
 	GetDocId() int64
+	// switches to descending order, must be called before iterating
+	reverse()
+	// the error that stopped the iteration early, if any
+	Err() error
+	// estimated number of matching documents
+	cost() int64
+}
+
+// descending order is ascending order of the mirrored doc ids, so the
+// boolean queries work the same way in both directions
+func mirror(docId int64) int64 {
+	return NO_MORE - 1 - docId
 }
 
 type QueryBase struct {
@@ -24,20 +41,137 @@ func (q *QueryBase) GetDocId() int64 {
 	return q.docId
 }
 
+// iterates over the compressed blocks of a postings list, only the
+// block the iteration is in is read and decoded, followed by the
+// postings that are not in a block yet
 type Term struct {
-	cursor   int
-	postings []int64
+	tag     string
+	source  io.ReaderAt
+	blocks  []postingsBlock
+	pending []int64
+
+	// logical block in iteration order
+	block  int
+	cursor int
+	// postings of the loaded block in iteration order
+	docs    []int64
+	loaded  int
+	buffer  []int64
+	payload []byte
+
+	reversed bool
+	err      error
+	size     int64
 	QueryBase
 }
 
 func NewTerm(postings []int64) *Term {
+	return newBlockTerm(nil, nil, postings)
+}
+
+func newBlockTerm(source io.ReaderAt, blocks []postingsBlock, pending []int64) *Term {
+	size := int64(len(pending))
+	for _, block := range blocks {
+		size += int64(block.count)
+	}
 	return &Term{
+		source:    source,
+		blocks:    blocks,
+		pending:   pending,
 		cursor:    -1,
-		postings:  postings,
+		loaded:    -1,
+		size:      size,
 		QueryBase: QueryBase{NOT_READY},
 	}
+}
+
+func (t *Term) cost() int64 {
+	return t.size
+}
 
+func (t *Term) Err() error {
+	return t.err
 }
+
+func (t *Term) numBlocks() int {
+	if len(t.pending) > 0 {
+		return len(t.blocks) + 1
+	}
+	return len(t.blocks)
+}
+
+// the index in blocks (or len(blocks) for the pending postings) of a
+// logical block
+func (t *Term) physical(block int) int {
+	if t.reversed {
+		return t.numBlocks() - 1 - block
+	}
+	return block
+}
+
+func (t *Term) count(block int) int {
+	p := t.physical(block)
+	if p == len(t.blocks) {
+		return len(t.pending)
+	}
+	return t.blocks[p].count
+}
+
+// the biggest doc id of a logical block, without reading it
+func (t *Term) last(block int) int64 {
+	p := t.physical(block)
+	if p == len(t.blocks) {
+		// already mirrored by reverse()
+		return t.pending[len(t.pending)-1]
+	}
+	if t.reversed {
+		return mirror(t.blocks[p].first)
+	}
+	return t.blocks[p].last
+}
+
+// reads and decodes the current block, returns nil and sets the error
+// if it can not be read
+func (t *Term) load() []int64 {
+	if t.loaded == t.block {
+		return t.docs
+	}
+
+	p := t.physical(t.block)
+	if p == len(t.blocks) {
+		// already mirrored by reverse()
+		t.docs = t.pending
+	} else {
+		block := t.blocks[p]
+		if cap(t.payload) < block.length {
+			t.payload = make([]byte, block.length)
+		}
+		payload := t.payload[:block.length]
+		_, err := t.source.ReadAt(payload, block.offset)
+		if err != nil {
+			t.err = err
+			return nil
+		}
+		t.buffer, err = decodePostings(t.buffer[:0], block.first, block.count, payload)
+		if err != nil {
+			t.err = err
+			return nil
+		}
+		if t.reversed {
+			mirrorInPlace(t.buffer)
+		}
+		t.docs = t.buffer
+	}
+	t.loaded = t.block
+	return t.docs
+}
+
+func (t *Term) exhausted() int64 {
+	t.block = t.numBlocks()
+	t.docId = NO_MORE
+	return NO_MORE
+}
+
 func (t *Term) advance(target int64) int64 {
 	if t.docId == NO_MORE || t.docId == target || target == NO_MORE {
 		t.docId = target
@@ -47,40 +181,62 @@ func (t *Term) advance(target int64) int64 {
 		t.cursor = 0
 	}
 
-	start := t.cursor
-	end := len(t.postings)
-
-	for start < end {
-		mid := start + ((end - start) / 2)
-		current := t.postings[mid]
-		if current == target {
-			t.cursor = mid
-			t.docId = target
-			return target
-		}
-
-		if current < target {
-			start = mid + 1
-		} else {
-			end = mid
-		}
+	n := t.numBlocks()
+	if t.block < n && t.last(t.block) < target {
+		// skip the blocks that end before the target without
+		// reading them
+		next := t.block + 1
+		t.block = next + sort.Search(n-next, func(i int) bool {
+			return t.last(next+i) >= target
+		})
+		t.cursor = 0
 	}
-	if start >= len(t.postings) {
-		t.docId = NO_MORE
-		return NO_MORE
+	if t.block >= n {
+		return t.exhausted()
 	}
-	t.cursor = start
-	t.docId = t.postings[start]
+
+	docs := t.load()
+	if docs == nil {
+		return t.exhausted()
+	}
+	// the last posting of the block is >= target
+	t.cursor += sort.Search(len(docs)-t.cursor, func(i int) bool {
+		return docs[t.cursor+i] >= target
+	})
+	t.docId = docs[t.cursor]
 	return t.docId
 }
 
+// reverses and mirrors the doc ids
+func mirrorInPlace(docs []int64) {
+	for i, j := 0, len(docs)-1; i <= j; i, j = i+1, j-1 {
+		docs[i], docs[j] = mirror(docs[j]), mirror(docs[i])
+	}
+}
+
+func (t *Term) reverse() {
+	pending := append([]int64{}, t.pending...)
+	mirrorInPlace(pending)
+	t.pending = pending
+	t.reversed = true
+}
+
 func (t *Term) Next() int64 {
 	t.cursor++
-	if t.cursor >= len(t.postings) {
-		t.docId = NO_MORE
-	} else {
-		t.docId = t.postings[t.cursor]
+	n := t.numBlocks()
+	for t.block < n && t.cursor >= t.count(t.block) {
+		t.block++
+		t.cursor = 0
+	}
+	if t.block >= n {
+		return t.exhausted()
 	}
+
+	docs := t.load()
+	if docs == nil {
+		return t.exhausted()
+	}
+	t.docId = docs[t.cursor]
 	return t.docId
 }
 
@@ -92,6 +248,21 @@ func (q *BoolQueryBase) AddSubQuery(sub Query) {
 	q.queries = append(q.queries, sub)
 }
 
+func (q *BoolQueryBase) Err() error {
+	for _, sub := range q.queries {
+		if err := sub.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *BoolQueryBase) reverse() {
+	for _, sub := range q.queries {
+		sub.reverse()
+	}
+}
+
 type BoolOrQuery struct {
 	BoolQueryBase
 	QueryBase
@@ -104,6 +275,14 @@ func NewBoolOrQuery(queries ...Query) *BoolOrQuery {
 	}
 }
 
+func (q *BoolOrQuery) cost() int64 {
+	sum := int64(0)
+	for _, sub := range q.queries {
+		sum += sub.cost()
+	}
+	return sum
+}
+
 func (q *BoolOrQuery) advance(target int64) int64 {
 	new_doc := NO_MORE
 	n := len(q.queries)
@@ -152,6 +331,16 @@ func NewBoolAndQuery(queries ...Query) *BoolAndQuery {
 	}
 }
 
+func (q *BoolAndQuery) cost() int64 {
+	min := int64(0)
+	for i, sub := range q.queries {
+		if c := sub.cost(); i == 0 || c < min {
+			min = c
+		}
+	}
+	return min
+}
+
 func (q *BoolAndQuery) nextAndedDoc(target int64) int64 {
 	// initial iteration skips queries[0]
 	n := len(q.queries)
@@ -187,21 +376,176 @@ func (q *BoolAndQuery) Next() int64 {
 		return NO_MORE
 	}
 
-	// XXX: pick cheapest leading query
+	// newAnd puts the cheapest query first
 	return q.nextAndedDoc(q.queries[0].Next())
 }
 
+// matches the documents of the positive query that are not matched by
+// the negated query
+type BoolNotQuery struct {
+	positive Query
+	negated  Query
+	QueryBase
+}
+
+func NewBoolNotQuery(positive, negated Query) *BoolNotQuery {
+	return &BoolNotQuery{
+		positive:  positive,
+		negated:   negated,
+		QueryBase: QueryBase{NOT_READY},
+	}
+}
+
+func (q *BoolNotQuery) skipNegated(doc int64) int64 {
+	for doc != NO_MORE {
+		negated := q.negated.GetDocId()
+		if negated < doc {
+			negated = q.negated.advance(doc)
+		}
+		if negated != doc {
+			break
+		}
+		doc = q.positive.Next()
+	}
+	q.docId = doc
+	return doc
+}
+
+func (q *BoolNotQuery) cost() int64 {
+	return q.positive.cost()
+}
+
+func (q *BoolNotQuery) Err() error {
+	if err := q.positive.Err(); err != nil {
+		return err
+	}
+	return q.negated.Err()
+}
+
+func (q *BoolNotQuery) reverse() {
+	q.positive.reverse()
+	q.negated.reverse()
+}
+
+func (q *BoolNotQuery) advance(target int64) int64 {
+	return q.skipNegated(q.positive.advance(target))
+}
+
+func (q *BoolNotQuery) Next() int64 {
+	return q.skipNegated(q.positive.Next())
+}
+
 /*
 
 {
-   and: [{"or": [{"tag":"b"}]}]
+   and: [{"or": [{"tag":"b"}]}, {"not": {"tag":"c"}}]
 }
 
 */
 
+var notWithoutPositiveError = errors.New("[not] needs a positive subquery next to it, e.g. {\"and\":[{\"tag\":\"a\"},{\"not\":{\"tag\":\"b\"}}]}")
+
+// the conjunction of the queries, nested conjunctions are flattened and
+// the cheapest query leads, as it drives the iteration and the others
+// only advance to its documents; if any query matches nothing, neither
+// does the conjunction
+func newAnd(queries []Query) Query {
+	flat := []Query{}
+	for _, q := range queries {
+		if and, ok := q.(*BoolAndQuery); ok {
+			flat = append(flat, and.queries...)
+		} else {
+			flat = append(flat, q)
+		}
+	}
+
+	if len(flat) == 0 {
+		return NewTerm([]int64{})
+	}
+	for _, q := range flat {
+		if q.cost() == 0 {
+			return NewTerm([]int64{})
+		}
+	}
+	if len(flat) == 1 {
+		return flat[0]
+	}
+
+	sort.SliceStable(flat, func(i, j int) bool {
+		return flat[i].cost() < flat[j].cost()
+	})
+	return NewBoolAndQuery(flat...)
+}
+
+// the disjunction of the queries, nested disjunctions are flattened and
+// queries that match nothing are dropped
+func newOr(queries []Query) Query {
+	flat := []Query{}
+	for _, q := range queries {
+		if or, ok := q.(*BoolOrQuery); ok {
+			flat = append(flat, or.queries...)
+		} else if q.cost() > 0 {
+			flat = append(flat, q)
+		}
+	}
+
+	if len(flat) == 0 {
+		return NewTerm([]int64{})
+	}
+	if len(flat) == 1 {
+		return flat[0]
+	}
+	return NewBoolOrQuery(flat...)
+}
+
+// excludes the negated queries from the positive ones
+func combine(queries []Query, negated []Query) (Query, error) {
+	positive := newAnd(queries)
+	if len(negated) == 0 {
+		return positive, nil
+	}
+	if len(queries) == 0 {
+		return nil, notWithoutPositiveError
+	}
+
+	excluded := newOr(negated)
+	if excluded.cost() == 0 || positive.cost() == 0 {
+		return positive, nil
+	}
+	return NewBoolNotQuery(positive, excluded), nil
+}
+
+// prefix and wildcard queries fail instead of expanding to more tags
+var maxExpansions = 1024
+
+// ORs the tags accepted by match
+func expand(store *StoreItem, clause string, match func(string) bool) (Query, error) {
+	lists := store.MatchingPostingsLists(match)
+	if len(lists) > maxExpansions {
+		return nil, fmt.Errorf("[%s] matches %d tags, more than the maximum of %d", clause, len(lists), maxExpansions)
+	}
+	queries := make([]Query, len(lists))
+	for i, pl := range lists {
+		queries[i] = pl.newTermQuery()
+	}
+	return newOr(queries), nil
+}
+
+// {"not": ...} is allowed only next to positive queries, so it is
+// parsed by the enclosing and
+func isNot(input interface{}) (interface{}, bool) {
+	mapped, ok := input.(map[string]interface{})
+	if !ok || len(mapped) != 1 {
+		return nil, false
+	}
+	v, ok := mapped["not"]
+	return v, ok && v != nil
+}
+
 func fromJSON(store *StoreItem, input interface{}) (Query, error) {
 	mapped, ok := input.(map[string]interface{})
 	queries := []Query{}
+	negated := []Query{}
 	if ok {
 
 		if v, ok := mapped["tag"]; ok && v != nil {
@@ -216,18 +560,74 @@ func fromJSON(store *StoreItem, input interface{}) (Query, error) {
 				queries = append(queries, pl.newTermQuery())
 			}
 		}
+		if v, ok := mapped["prefix"]; ok && v != nil {
+			value, ok := v.(string)
+			if !ok {
+				return nil, errors.New("[prefix] must be a string")
+			}
+			prefix := sanitize(value)
+			q, err := expand(store, "prefix", func(name string) bool {
+				return strings.HasPrefix(name, prefix)
+			})
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, q)
+		}
+
+		if v, ok := mapped["wildcard"]; ok && v != nil {
+			value, ok := v.(string)
+			if !ok {
+				return nil, errors.New("[wildcard] must be a string")
+			}
+			_, err := path.Match(value, "")
+			if err != nil {
+				return nil, fmt.Errorf("[wildcard] %s", err.Error())
+			}
+			q, err := expand(store, "wildcard", func(name string) bool {
+				matched, _ := path.Match(value, name)
+				return matched
+			})
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, q)
+		}
+
+		if v, ok := mapped["range"]; ok && v != nil {
+			q, err := rangeFromJSON(store, v)
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, q)
+		}
+
 		if v, ok := mapped["and"]; ok && v != nil {
 			list, ok := v.([]interface{})
 			if ok {
-				and := NewBoolAndQuery([]Query{}...)
+				and := []Query{}
+				andNot := []Query{}
 				for _, subQuery := range list {
+					if notQuery, ok := isNot(subQuery); ok {
+						q, err := fromJSON(store, notQuery)
+						if err != nil {
+							return nil, err
+						}
+						andNot = append(andNot, q)
+						continue
+					}
+
 					q, err := fromJSON(store, subQuery)
 					if err != nil {
 						return nil, err
 					}
-					and.AddSubQuery(q)
+					and = append(and, q)
 				}
-				queries = append(queries, and)
+				q, err := combine(and, andNot)
+				if err != nil {
+					return nil, err
+				}
+				queries = append(queries, q)
 			} else {
 				return nil, errors.New("[or] takes array of subqueries")
 			}
@@ -236,24 +636,28 @@ func fromJSON(store *StoreItem, input interface{}) (Query, error) {
 		if v, ok := mapped["or"]; ok && v != nil {
 			list, ok := v.([]interface{})
 			if ok {
-				or := NewBoolOrQuery([]Query{}...)
+				or := []Query{}
 				for _, subQuery := range list {
 					q, err := fromJSON(store, subQuery)
 					if err != nil {
 						return nil, err
 					}
-					or.AddSubQuery(q)
+					or = append(or, q)
 				}
-				queries = append(queries, or)
+				queries = append(queries, newOr(or))
 			} else {
 				return nil, errors.New("[and] takes array of subqueries")
 			}
 		}
-	}
 
-	if len(queries) == 1 {
-		return queries[0], nil
+		if v, ok := mapped["not"]; ok && v != nil {
+			q, err := fromJSON(store, v)
+			if err != nil {
+				return nil, err
+			}
+			negated = append(negated, q)
+		}
 	}
 
-	return NewBoolAndQuery(queries...), nil
+	return combine(queries, negated)
 }
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"encoding/json"
+	"os"
+	"path"
 	"testing"
 )
 
@@ -152,3 +155,337 @@ func TestModify(t *testing.T) {
 		NewTerm(e),
 	)))
 }
+
+func TestNot(t *testing.T) {
+	a := postingsList(100)
+	b := postingsList(10)
+
+	eq(t, a[10:], query(NewBoolNotQuery(NewTerm(a), NewTerm(b))))
+	eq(t, []int64{}, query(NewBoolNotQuery(NewTerm(b), NewTerm(a))))
+	eq(t, a, query(NewBoolNotQuery(NewTerm(a), NewTerm([]int64{}))))
+	eq(t, []int64{3, 6, 12}, query(NewBoolNotQuery(
+		NewTerm([]int64{0, 3, 6, 9, 12}),
+		NewBoolOrQuery(
+			NewTerm([]int64{0, 1}),
+			NewTerm([]int64{9, 100}),
+		),
+	)))
+
+	eq(t, a[20:30], query(NewBoolAndQuery(
+		NewTerm(postingsList(30)),
+		NewBoolNotQuery(NewTerm(a), NewTerm(postingsList(20))),
+	)))
+
+	path := path.Join(os.TempDir(), "rochefort_not_test")
+	os.RemoveAll(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	expected := []int64{}
+	for i := 0; i < 30; i++ {
+		tags := []string{"error"}
+		if i%3 == 0 {
+			tags = append(tags, "healthcheck")
+		}
+		offset, _ := storage.append(16, []byte("x"), tags...)
+		if i%3 != 0 {
+			expected = append(expected, int64(offset))
+		}
+	}
+
+	for _, input := range []string{
+		`{"and":[{"tag":"error"},{"not":{"tag":"healthcheck"}}]}`,
+		`{"tag":"error","not":{"tag":"healthcheck"}}`,
+		`{"and":[{"not":{"tag":"healthcheck"}},{"tag":"error"},{"not":{"tag":"missing"}}]}`,
+	} {
+		var decoded interface{}
+		json.Unmarshal([]byte(input), &decoded)
+		q, err := fromJSON(storage, decoded)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		eq(t, expected, query(q))
+	}
+
+	for _, input := range []string{
+		`{"not":{"tag":"healthcheck"}}`,
+		`{"and":[{"not":{"tag":"healthcheck"}}]}`,
+		`{"or":[{"tag":"error"},{"not":{"tag":"healthcheck"}}]}`,
+	} {
+		var decoded interface{}
+		json.Unmarshal([]byte(input), &decoded)
+		_, err := fromJSON(storage, decoded)
+		if err == nil {
+			t.Logf("expected error for %s", input)
+			t.FailNow()
+		}
+	}
+	os.RemoveAll(path)
+}
+
+func TestQueryPagination(t *testing.T) {
+	a := postingsList(100)
+	b := postingsList(10)
+
+	reversed := func(list []int64) []int64 {
+		out := make([]int64, len(list))
+		for i, docId := range list {
+			out[len(list)-1-i] = mirror(docId)
+		}
+		return out
+	}
+
+	q := NewBoolAndQuery(NewTerm(a), NewBoolOrQuery(NewTerm(b), NewTerm([]int64{30, 31, 33})))
+	q.reverse()
+	eq(t, reversed(append(b, 30, 33)), query(q))
+
+	not := NewBoolNotQuery(NewTerm(a[:20]), NewTerm(b))
+	not.reverse()
+	eq(t, reversed(a[10:20]), query(not))
+
+	path := path.Join(os.TempDir(), "rochefort_pagination_test")
+	os.RemoveAll(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	offsets := []uint64{}
+	for i := 0; i < 20; i++ {
+		offset, _ := storage.append(16, []byte("x"), "a")
+		offsets = append(offsets, offset)
+	}
+	storage.deleteRecord(offsets[17])
+
+	page := func(options QueryOptions) []uint64 {
+		out := []uint64{}
+		storage.ExecuteQuery(storage.GetPostingsList("a").newTermQuery(), options, func(offset uint64, header *Header, data []byte) bool {
+			out = append(out, offset)
+			return true
+		})
+		return out
+	}
+	eqOffsets := func(a, b []uint64) {
+		if len(a) != len(b) {
+			t.Logf("expected %v got %v", a, b)
+			t.FailNow()
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				t.Logf("expected %v got %v", a, b)
+				t.FailNow()
+			}
+		}
+	}
+
+	eqOffsets(offsets[:5], page(QueryOptions{limit: 5}))
+	eqOffsets(offsets[5:10], page(QueryOptions{limit: 5, hasAfter: true, after: offsets[4]}))
+	eqOffsets([]uint64{offsets[19], offsets[18], offsets[16]}, page(QueryOptions{limit: 3, descending: true}))
+	eqOffsets([]uint64{offsets[15], offsets[14]}, page(QueryOptions{limit: 2, descending: true, hasAfter: true, after: offsets[16]}))
+	eqOffsets([]uint64{}, page(QueryOptions{descending: true, hasAfter: true, after: offsets[0]}))
+	eqOffsets([]uint64{}, page(QueryOptions{hasAfter: true, after: offsets[19]}))
+	os.RemoveAll(path)
+}
+
+func TestCountQuery(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_count_test")
+	os.RemoveAll(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 30; i++ {
+		tags := []string{"error"}
+		if i%3 == 0 {
+			tags = append(tags, "healthcheck")
+		}
+		if i%5 == 0 {
+			tags = append(tags, "db")
+		}
+		storage.append(16, []byte("x"), tags...)
+	}
+	storage.append(16, []byte("x"), "db")
+
+	var decoded interface{}
+	json.Unmarshal([]byte(`{"and":[{"tag":"error"},{"not":{"tag":"healthcheck"}}]}`), &decoded)
+	out, err := storage.countQuery(func() (Query, error) {
+		return fromJSON(storage, decoded)
+	}, []string{"db", "healthcheck", "missing"})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	if out.Count != 20 || out.Facets["db"] != 4 || out.Facets["healthcheck"] != 0 || out.Facets["missing"] != 0 || len(out.Facets) != 3 {
+		t.Logf("unexpected counts %v", out)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestPrefixAndWildcard(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_prefix_test")
+	os.RemoveAll(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	offsets := map[string]int64{}
+	for _, tag := range []string{"customer_123_order_1", "customer_123_order_2", "customer_124_order_1", "country_nl", "country_bg"} {
+		offset, _ := storage.append(16, []byte(tag), tag, "all")
+		offsets[tag] = int64(offset)
+	}
+
+	run := func(input string) ([]int64, error) {
+		var decoded interface{}
+		json.Unmarshal([]byte(input), &decoded)
+		q, err := fromJSON(storage, decoded)
+		if err != nil {
+			return nil, err
+		}
+		return query(q), nil
+	}
+
+	for input, expected := range map[string][]int64{
+		`{"prefix":"customer_123"}`:                                []int64{offsets["customer_123_order_1"], offsets["customer_123_order_2"]},
+		`{"prefix":"country"}`:                                     []int64{offsets["country_nl"], offsets["country_bg"]},
+		`{"prefix":"nothing"}`:                                     []int64{},
+		`{"wildcard":"customer_*_order_1"}`:                        []int64{offsets["customer_123_order_1"], offsets["customer_124_order_1"]},
+		`{"wildcard":"country_?g"}`:                                []int64{offsets["country_bg"]},
+		`{"and":[{"tag":"all"},{"not":{"wildcard":"country_*"}}]}`: []int64{offsets["customer_123_order_1"], offsets["customer_123_order_2"], offsets["customer_124_order_1"]},
+	} {
+		out, err := run(input)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		eq(t, expected, out)
+	}
+
+	_, err := run(`{"wildcard":"["}`)
+	if err == nil {
+		t.Log("expected error for a malformed pattern")
+		t.FailNow()
+	}
+
+	maxExpansions = 2
+	_, err = run(`{"prefix":"c"}`)
+	maxExpansions = 1024
+	if err == nil {
+		t.Log("expected error when expanding to too many tags")
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestQueryPlanning(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_planning_test")
+	os.RemoveAll(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	rare := []int64{}
+	for i := 0; i < 1000; i++ {
+		tags := []string{"common"}
+		if i%100 == 0 {
+			tags = append(tags, "rare")
+		}
+		if i%10 == 0 {
+			tags = append(tags, "some")
+		}
+		offset, _ := storage.append(16, []byte("x"), tags...)
+		if i%100 == 0 {
+			rare = append(rare, int64(offset))
+		}
+	}
+
+	parse := func(input string) Query {
+		var decoded interface{}
+		json.Unmarshal([]byte(input), &decoded)
+		q, err := fromJSON(storage, decoded)
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		return q
+	}
+
+	q := parse(`{"and":[{"tag":"common"},{"and":[{"tag":"some"},{"tag":"rare"}]}]}`)
+	and, ok := q.(*BoolAndQuery)
+	if !ok || len(and.queries) != 3 {
+		t.Logf("expected flat and with 3 queries, got %#v", q)
+		t.FailNow()
+	}
+	for i, expected := range []int64{10, 100, 1000} {
+		if and.queries[i].cost() != expected {
+			t.Logf("expected cost %d at %d, got %d", expected, i, and.queries[i].cost())
+			t.FailNow()
+		}
+	}
+	eq(t, rare, query(q))
+
+	q = parse(`{"or":[{"tag":"rare"},{"or":[{"tag":"missing"},{"tag":"some"}]}]}`)
+	or, ok := q.(*BoolOrQuery)
+	if !ok || len(or.queries) != 2 || q.cost() != 110 {
+		t.Logf("expected flat or with 2 queries, got %#v", q)
+		t.FailNow()
+	}
+
+	for _, input := range []string{
+		`{"and":[{"tag":"common"},{"tag":"missing"}]}`,
+		`{"and":[{"tag":"common"},{"or":[{"tag":"missing"}]}]}`,
+		`{"tag":"missing","not":{"tag":"rare"}}`,
+	} {
+		q = parse(input)
+		if term, ok := q.(*Term); !ok || term.cost() != 0 {
+			t.Logf("expected empty term for %s, got %#v", input, q)
+			t.FailNow()
+		}
+	}
+
+	q = parse(`{"tag":"rare","not":{"tag":"missing"}}`)
+	if _, ok := q.(*Term); !ok {
+		t.Logf("expected the not of a missing tag to be dropped, got %#v", q)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestExplain(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_explain_test")
+	os.RemoveAll(path)
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 100; i++ {
+		tags := []string{"common"}
+		if i%10 == 0 {
+			tags = append(tags, "rare")
+		}
+		if i%20 == 0 {
+			tags = append(tags, "excluded")
+		}
+		storage.append(16, []byte("0123456789"), tags...)
+	}
+
+	var decoded interface{}
+	json.Unmarshal([]byte(`{"and":[{"tag":"common"},{"tag":"rare"},{"not":{"tag":"excluded"}}]}`), &decoded)
+	q, _ := fromJSON(storage, decoded)
+	out, err := storage.explainQuery(q, QueryOptions{})
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	if out.Matches != 5 || out.BytesRead != 5*(uint64(headerLen)+10) || out.TookNanoseconds <= 0 {
+		t.Logf("unexpected explain %v", out)
+		t.FailNow()
+	}
+
+	not := out.Query
+	if not.Type != "not" || len(not.Children) != 2 || not.Docs != 5 {
+		t.Logf("unexpected root %v", not)
+		t.FailNow()
+	}
+	and, excluded := not.Children[0], not.Children[1]
+	if and.Type != "and" || len(and.Children) != 2 || excluded.Tag != "excluded" || excluded.Cost != 5 {
+		t.Logf("unexpected children %v %v", and, excluded)
+		t.FailNow()
+	}
+	rare, common := and.Children[0], and.Children[1]
+	if rare.Tag != "rare" || rare.Cost != 10 || rare.Next != 11 || common.Tag != "common" || common.Cost != 100 || common.Advance != 11 {
+		t.Logf("unexpected terms %v %v", rare, common)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
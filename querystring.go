@@ -0,0 +1,307 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// parses the q= query string syntax, e.g.
+//
+//   error AND (db OR cache) AND NOT healthcheck AND customer_12*
+//
+// into the same structure as the json dsl, so it goes through fromJSON.
+// AND binds tighter than OR, the keywords are upper case (lower case
+// and, or, not are tags), a word ending with * is a prefix, and a word
+// with * or ? anywhere else is a wildcard; NOT can be used in an OR if
+// the OR is in an AND with a positive term
+
+const (
+	tokenWord = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+	tokenEnd
+)
+
+type queryToken struct {
+	kind int
+	text string
+	pos  int
+}
+
+func (this queryToken) String() string {
+	if this.kind == tokenEnd {
+		return "end of query"
+	}
+	return fmt.Sprintf("%q", this.text)
+}
+
+type queryParser struct {
+	input  string
+	tokens []queryToken
+	next   int
+}
+
+// a parsed expression, negated ones can only be used in an AND next to
+// a positive one
+type parsedQuery struct {
+	query   map[string]interface{}
+	negated bool
+	// the alternatives of an OR with negated ones, e.g. b OR NOT d, it
+	// is distributed over the positive terms of the enclosing AND:
+	// c AND (b OR NOT d) is (c AND b) OR (c AND NOT d)
+	or  []parsedQuery
+	pos int
+}
+
+func (this parsedQuery) conjunct() interface{} {
+	if this.negated {
+		return map[string]interface{}{"not": this.query}
+	}
+	return this.query
+}
+
+func isQueryWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '*' || c == '?'
+}
+
+func (this *queryParser) errorAt(pos int, format string, args ...interface{}) error {
+	return fmt.Errorf("[q] %s at position %d\n%s\n%s^", fmt.Sprintf(format, args...), pos, this.input, strings.Repeat(" ", pos))
+}
+
+func (this *queryParser) tokenize() error {
+	for i := 0; i < len(this.input); {
+		c := this.input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(':
+			this.tokens = append(this.tokens, queryToken{kind: tokenOpen, text: "(", pos: i})
+			i++
+		case c == ')':
+			this.tokens = append(this.tokens, queryToken{kind: tokenClose, text: ")", pos: i})
+			i++
+		case isQueryWordChar(c):
+			start := i
+			for i < len(this.input) && isQueryWordChar(this.input[i]) {
+				i++
+			}
+			token := queryToken{kind: tokenWord, text: this.input[start:i], pos: start}
+			switch token.text {
+			case "AND":
+				token.kind = tokenAnd
+			case "OR":
+				token.kind = tokenOr
+			case "NOT":
+				token.kind = tokenNot
+			}
+			this.tokens = append(this.tokens, token)
+		default:
+			return this.errorAt(i, "unexpected character %q", c)
+		}
+	}
+	this.tokens = append(this.tokens, queryToken{kind: tokenEnd, pos: len(this.input)})
+	return nil
+}
+
+func (this *queryParser) peek() queryToken {
+	return this.tokens[this.next]
+}
+
+func (this *queryParser) consume() queryToken {
+	token := this.tokens[this.next]
+	if token.kind != tokenEnd {
+		this.next++
+	}
+	return token
+}
+
+func (this *queryParser) negationError(q parsedQuery) error {
+	return this.errorAt(q.pos, "NOT needs a positive term next to it in an AND, e.g. a AND NOT b")
+}
+
+func (this *queryParser) parseOr() (parsedQuery, error) {
+	first, err := this.parseAnd()
+	if err != nil {
+		return first, err
+	}
+	if this.peek().kind != tokenOr {
+		return first, nil
+	}
+
+	items := []parsedQuery{first}
+	for this.peek().kind == tokenOr {
+		this.consume()
+		item, err := this.parseAnd()
+		if err != nil {
+			return item, err
+		}
+		items = append(items, item)
+	}
+
+	alternatives := []parsedQuery{}
+	mixed := false
+	for _, item := range items {
+		if item.or != nil {
+			alternatives = append(alternatives, item.or...)
+			mixed = true
+			continue
+		}
+		alternatives = append(alternatives, item)
+		mixed = mixed || item.negated
+	}
+	if mixed {
+		return parsedQuery{or: alternatives, pos: first.pos}, nil
+	}
+
+	or := []interface{}{}
+	for _, item := range alternatives {
+		or = append(or, item.query)
+	}
+	return parsedQuery{query: map[string]interface{}{"or": or}, pos: first.pos}, nil
+}
+
+func (this *queryParser) parseAnd() (parsedQuery, error) {
+	first, err := this.parseUnary()
+	if err != nil {
+		return first, err
+	}
+	if this.peek().kind != tokenAnd {
+		return first, nil
+	}
+
+	items := []parsedQuery{first}
+	for this.peek().kind == tokenAnd {
+		this.consume()
+		item, err := this.parseUnary()
+		if err != nil {
+			return item, err
+		}
+		items = append(items, item)
+	}
+
+	and := []interface{}{}
+	mixed := []parsedQuery{}
+	positive := false
+	for _, item := range items {
+		if item.or != nil {
+			mixed = append(mixed, item)
+			continue
+		}
+		and = append(and, item.conjunct())
+		positive = positive || !item.negated
+	}
+	if !positive {
+		return first, this.negationError(first)
+	}
+	if len(mixed) == 0 {
+		return parsedQuery{query: map[string]interface{}{"and": and}, pos: first.pos}, nil
+	}
+
+	// every combination of the alternatives gets the other conjuncts,
+	// their number grows exponentially, so it is limited like the
+	// expansions of a prefix or wildcard
+	combinations := [][]interface{}{and}
+	for _, item := range mixed {
+		if len(combinations)*len(item.or) > maxExpansions {
+			return item, this.errorAt(item.pos, "NOT inside OR expands to more than %d combinations", maxExpansions)
+		}
+		next := [][]interface{}{}
+		for _, combination := range combinations {
+			for _, alternative := range item.or {
+				conjuncts := append(combination[:len(combination):len(combination)], alternative.conjunct())
+				next = append(next, conjuncts)
+			}
+		}
+		combinations = next
+	}
+	or := []interface{}{}
+	for _, conjuncts := range combinations {
+		or = append(or, map[string]interface{}{"and": conjuncts})
+	}
+	return parsedQuery{query: map[string]interface{}{"or": or}, pos: first.pos}, nil
+}
+
+func (this *queryParser) parseUnary() (parsedQuery, error) {
+	token := this.consume()
+	switch token.kind {
+	case tokenNot:
+		inner, err := this.parseUnary()
+		if err != nil {
+			return inner, err
+		}
+		if inner.or != nil {
+			return inner, this.errorAt(token.pos, "NOT of an OR with NOT inside is not supported")
+		}
+		return parsedQuery{query: inner.query, negated: !inner.negated, pos: token.pos}, nil
+	case tokenOpen:
+		inner, err := this.parseOr()
+		if err != nil {
+			return inner, err
+		}
+		closing := this.consume()
+		if closing.kind != tokenClose {
+			return inner, this.errorAt(closing.pos, "expected \")\" to close the \"(\" at position %d, got %s", token.pos, closing)
+		}
+		inner.pos = token.pos
+		return inner, nil
+	case tokenWord:
+		return this.parseWord(token)
+	}
+	return parsedQuery{}, this.errorAt(token.pos, "expected a tag, NOT or \"(\", got %s", token)
+}
+
+func (this *queryParser) parseWord(token queryToken) (parsedQuery, error) {
+	word := token.text
+	q := parsedQuery{pos: token.pos}
+	if !strings.ContainsAny(word, "*?") {
+		q.query = map[string]interface{}{"tag": word}
+		return q, nil
+	}
+
+	prefix := strings.TrimSuffix(word, "*")
+	if !strings.ContainsAny(prefix, "*?") {
+		q.query = map[string]interface{}{"prefix": prefix}
+		return q, nil
+	}
+
+	_, err := path.Match(word, "")
+	if err != nil {
+		return q, this.errorAt(token.pos, "invalid wildcard %s: %s", token, err.Error())
+	}
+	q.query = map[string]interface{}{"wildcard": word}
+	return q, nil
+}
+
+func parseQueryString(input string) (map[string]interface{}, error) {
+	parser := &queryParser{input: input}
+	err := parser.tokenize()
+	if err != nil {
+		return nil, err
+	}
+	if parser.peek().kind == tokenEnd {
+		return nil, parser.errorAt(0, "empty query")
+	}
+
+	q, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.kind != tokenEnd {
+		return nil, parser.errorAt(token.pos, "unexpected %s, expected AND, OR or end of query", token)
+	}
+	if q.or != nil {
+		for _, alternative := range q.or {
+			if alternative.negated {
+				return nil, parser.negationError(alternative)
+			}
+		}
+	}
+	if q.negated {
+		return nil, parser.negationError(q)
+	}
+	return q.query, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestParseQueryString(t *testing.T) {
+	for input, expected := range map[string]string{
+		`c`:                               `{"tag":"c"}`,
+		`c AND (b OR c)`:                  `{"and":[{"tag":"c"},{"or":[{"tag":"b"},{"tag":"c"}]}]}`,
+		`c AND (b OR NOT d)`:              `{"or":[{"and":[{"tag":"c"},{"tag":"b"}]},{"and":[{"tag":"c"},{"not":{"tag":"d"}}]}]}`,
+		`(a OR NOT b) AND c AND (d OR e)`: `{"or":[{"and":[{"tag":"c"},{"or":[{"tag":"d"},{"tag":"e"}]},{"tag":"a"}]},{"and":[{"tag":"c"},{"or":[{"tag":"d"},{"tag":"e"}]},{"not":{"tag":"b"}}]}]}`,
+		`a OR b AND c`:                    `{"or":[{"tag":"a"},{"and":[{"tag":"b"},{"tag":"c"}]}]}`,
+		`error AND NOT healthcheck`:       `{"and":[{"tag":"error"},{"not":{"tag":"healthcheck"}}]}`,
+		`NOT (a OR b) AND c`:              `{"and":[{"not":{"or":[{"tag":"a"},{"tag":"b"}]}},{"tag":"c"}]}`,
+		`NOT NOT a`:                       `{"tag":"a"}`,
+		`user_12* AND customer_*_order_1`: `{"and":[{"prefix":"user_12"},{"wildcard":"customer_*_order_1"}]}`,
+		`country_?? OR and`:               `{"or":[{"wildcard":"country_??"},{"tag":"and"}]}`,
+		` ( ( a ) ) `:                     `{"tag":"a"}`,
+	} {
+		q, err := parseQueryString(input)
+		if err != nil {
+			t.Logf("%s: %s", input, err.Error())
+			t.FailNow()
+		}
+		encoded, _ := json.Marshal(q)
+		if string(encoded) != expected {
+			t.Logf("%s: expected %s got %s", input, expected, string(encoded))
+			t.FailNow()
+		}
+	}
+
+	for input, position := range map[string]int{
+		``:                       0,
+		`a AND`:                  5,
+		`a AND )`:                6,
+		`(a OR b`:                7,
+		`a b`:                    2,
+		`a AND b-c`:              7,
+		`NOT a`:                  0,
+		`(a OR NOT b)`:           6,
+		`c AND NOT (a OR NOT b)`: 6,
+		`NOT a AND NOT b`:        0,
+		`a AND (NOT b)`:          -1,
+		`a OR (b AND NOT c`:      17,
+	} {
+		_, err := parseQueryString(input)
+		if position < 0 {
+			if err != nil {
+				t.Logf("%s: unexpected error %s", input, err.Error())
+				t.FailNow()
+			}
+			continue
+		}
+		if err == nil {
+			t.Logf("%s: expected error at %d", input, position)
+			t.FailNow()
+		}
+		lines := strings.Split(err.Error(), "\n")
+		if len(lines) != 3 || lines[1] != input || lines[2] != strings.Repeat(" ", position)+"^" {
+			t.Logf("%s: expected error at %d, got %s", input, position, err.Error())
+			t.FailNow()
+		}
+	}
+
+	// 2^10 combinations are allowed, the 11th group is one too many
+	input := "a"
+	for i := 0; i < 11; i++ {
+		input += fmt.Sprintf(" AND (b%d OR NOT c%d)", i, i)
+	}
+	_, err := parseQueryString(input)
+	position := strings.Index(input, "(b10")
+	if err == nil || strings.Split(err.Error(), "\n")[2] != strings.Repeat(" ", position)+"^" {
+		t.Logf("expected error at %d, got %v", position, err)
+		t.FailNow()
+	}
+	_, err = parseQueryString(input[:strings.Index(input, " AND (b10")])
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+}
+
+func TestQueryStringNegationInOr(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_querystring_test")
+	os.RemoveAll(root)
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+
+	offsets := []int64{}
+	for _, tags := range [][]string{{"c", "b"}, {"c", "d"}, {"c"}, {"b"}, {"c", "b", "d"}, {"d"}} {
+		offset, _ := storage.append(0, []byte("x"), tags...)
+		offsets = append(offsets, int64(offset))
+	}
+
+	parsed, err := parseQueryString("c AND (b OR NOT d)")
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	q, err := fromJSON(storage, parsed)
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	eq(t, query(q), []int64{offsets[0], offsets[2], offsets[4]})
+	os.RemoveAll(root)
+}
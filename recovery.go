@@ -0,0 +1,133 @@
+package main
+
+import (
+	"log"
+	"strings"
+)
+
+// walks the headers of append.raw and cuts a torn tail left by a crash
+// in the middle of append(), returns the offset of the next append
+//
+// corrupted records in the middle of the file are left alone (compact
+// will skip them), only data after the last valid record is removed
+func (this *StoreItem) recover(size uint64) uint64 {
+	end := uint64(0)
+	valid := 0
+	for offset := uint64(0); offset < size; {
+		uncorruptedOffset, header, err := gotoNextValidHeader(this.descriptor, offset, size)
+		if err != nil {
+			break
+		}
+		if uncorruptedOffset != offset {
+			log.Printf("%s recovery: corrupt header, skipped from %d to %d", this.root, offset, uncorruptedOffset)
+		}
+		offset = uncorruptedOffset
+
+		dataEnd := offset + header.size() + uint64(header.dataLen)
+		if dataEnd > size {
+			log.Printf("%s recovery: record at %d ends at %d, after the end of the file %d", this.root, offset, dataEnd, size)
+			break
+		}
+
+		next := offset + header.size() + uint64(header.allocSize)
+		if next >= size {
+			// the last record, make sure its value made it to disk
+			value := make([]byte, header.dataLen)
+			_, err = this.descriptor.ReadAt(value, int64(offset+header.size()))
+			if err != nil || header.verify(value) != nil {
+				log.Printf("%s recovery: last record at %d has incomplete value", this.root, offset)
+				break
+			}
+		}
+
+		this.times.add(header.time, offset)
+		valid++
+		end = next
+		offset = next
+	}
+
+	if valid == 0 && size > 0 {
+		log.Printf("%s recovery: no valid records found in %d bytes, not touching it and refusing to compact it", this.root, size)
+		this.unrecognized = true
+		return size
+	}
+
+	if end < size {
+		log.Printf("%s recovery: truncating torn tail from %d to %d", this.root, size, end)
+		err := this.descriptor.Truncate(int64(end))
+		if err != nil {
+			log.Printf("%s recovery: failed to truncate, err: %s", this.root, err.Error())
+			return size
+		}
+	}
+
+	// the space reserved for the last record might not be on disk yet,
+	// but the next append must not overwrite it
+	return end
+}
+
+// drops postings pointing after the last valid record and sorts
+// postings written out of order, torn blocks and partial entries are
+// dropped when the postings list is opened; the postings are read only
+// if the skip data of the blocks and the tail show that it is needed
+func (this *PostingsList) recover(end uint64) {
+	if this.sortedBefore(end) {
+		return
+	}
+
+	postings, err := this.list()
+	if err != nil {
+		log.Printf("%s recovery: failed to read, err: %s", this.path, err.Error())
+		return
+	}
+
+	kept := make([]int64, 0, len(postings))
+	for _, offset := range postings {
+		if offset >= 0 && uint64(offset) < end {
+			kept = append(kept, offset)
+		}
+	}
+	if len(kept) != len(postings) {
+		log.Printf("%s recovery: dropping %d postings pointing after %d", this.path, len(postings)-len(kept), end)
+	}
+
+	sorted := isSorted(kept)
+	if !sorted {
+		log.Printf("%s recovery: postings are out of order, sorting them", this.path)
+		kept = sortedUnique(kept)
+	}
+
+	if !sorted || len(kept) != len(postings) {
+		err = this.rewrite(kept)
+		if err != nil {
+			log.Printf("%s recovery: failed to rewrite, err: %s", this.path, err.Error())
+		}
+	}
+}
+
+// true if the blocks and the tail are in order and the last posting
+// points before end
+func (this *PostingsList) sortedBefore(end uint64) bool {
+	this.Lock()
+	defer this.Unlock()
+
+	last := int64(-1)
+	for _, block := range this.blocks {
+		if block.first <= last || block.last < block.first {
+			return false
+		}
+		last = block.last
+	}
+	for _, p := range this.pending {
+		if p <= last {
+			return false
+		}
+		last = p
+	}
+	return last < int64(end)
+}
+
+// temporary files left by a crash during compaction or rewrite
+func isLeftover(name string) bool {
+	return strings.HasSuffix(name, ".tmp") || strings.HasSuffix(name, ".compact") || strings.HasSuffix(name, compactedSuffix)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestRecovery(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_recovery_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 10; i++ {
+		storage.append(64, []byte(fmt.Sprintf("%d", i)), "a")
+	}
+	end := storage.offset
+
+	// header of a record whose value never made it to disk
+	value := []byte("torn value")
+	writeHeader(storage.descriptor, end, newHeader(value, 64))
+	storage.descriptor.WriteAt(value[:3], int64(end)+int64(headerLen))
+	p := storage.GetPostingsList("a")
+	p.append(end, false)
+
+	// half of a posting
+	p.descriptor.WriteAt([]byte{1, 2, 3}, int64(p.offset))
+
+	storage.descriptor.Close()
+	p.descriptor.Close()
+
+	storage = NewStorage(path, Durability{})
+	defer storage.close()
+	if storage.offset != end {
+		t.Logf("expected offset %d, got %d", end, storage.offset)
+		t.FailNow()
+	}
+
+	fi, _ := storage.descriptor.Stat()
+	if uint64(fi.Size()) > end {
+		t.Logf("torn tail was not truncated, size: %d", fi.Size())
+		t.FailNow()
+	}
+
+	postings := query(storage.GetPostingsList("a").newTermQuery())
+	if len(postings) != 10 {
+		t.Logf("expected 10 postings, got %d", len(postings))
+		t.FailNow()
+	}
+
+	offset, _ := storage.append(64, []byte("after"), "a")
+	if offset != end {
+		t.Logf("expected next append at %d, got %d", end, offset)
+		t.FailNow()
+	}
+	data, err := storage.read(offset)
+	if err != nil || string(data) != "after" {
+		t.Logf("unexpected %s %v", string(data), err)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
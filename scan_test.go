@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	mr "math/rand"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestScanByTime(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_scan_time_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 100; i++ {
+		storage.append(uint32(mr.Int31n(100)), []byte(fmt.Sprintf("%d", i)))
+	}
+
+	times := []int64{}
+	storage.scan(ScanOptions{}, func(offset uint64, header *Header, data []byte) bool {
+		times = append(times, header.time)
+		return true
+	})
+
+	from := times[40]
+	to := times[60]
+	expected := 0
+	for _, t := range times {
+		if t >= from && t <= to {
+			expected++
+		}
+	}
+
+	found := 0
+	storage.scan(ScanOptions{fromTime: from, toTime: to}, func(offset uint64, header *Header, data []byte) bool {
+		if header.time < from || header.time > to {
+			t.Logf("record at %d is out of range", offset)
+			t.FailNow()
+		}
+		found++
+		return true
+	})
+	if found != expected {
+		t.Logf("expected %d records, got %d", expected, found)
+		t.FailNow()
+	}
+
+	if storage.findOffsetByTime(times[99]+1) != storage.offset {
+		t.Log("expected the end of the file for time after the last record")
+		t.FailNow()
+	}
+	if storage.findOffsetByTime(1) != 0 {
+		t.Log("expected the beginning of the file")
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestScanRange(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_scan_range_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 1000; i++ {
+		storage.append(uint32(mr.Int31n(100)), []byte(fmt.Sprintf("%d", i)))
+	}
+
+	// page through with a cursor
+	seen := 0
+	next := uint64(0)
+	for {
+		page := 0
+		n, err := storage.scan(ScanOptions{from: next, limit: 33}, func(offset uint64, header *Header, data []byte) bool {
+			if string(data) != fmt.Sprintf("%d", seen) {
+				t.Logf("expected %d got %s", seen, string(data))
+				t.FailNow()
+			}
+			seen++
+			page++
+			return true
+		})
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		next = n
+		if page == 0 {
+			break
+		}
+	}
+	if seen != 1000 || next != storage.offset {
+		t.Logf("expected 1000 records, got %d, next: %d", seen, next)
+		t.FailNow()
+	}
+
+	// split the file in ranges that do not match the record boundaries
+	seen = 0
+	step := storage.offset / 7
+	for from := uint64(0); from < storage.offset; from += step {
+		storage.scan(ScanOptions{from: from, to: from + step}, func(offset uint64, header *Header, data []byte) bool {
+			if string(data) != fmt.Sprintf("%d", seen) {
+				t.Logf("expected %d got %s", seen, string(data))
+				t.FailNow()
+			}
+			seen++
+			return true
+		})
+	}
+	if seen != 1000 {
+		t.Logf("expected 1000 records, got %d", seen)
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
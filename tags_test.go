@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestListTags(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_tags_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i, tag := range []string{"user_1", "user_2", "user_3", "country_nl"} {
+		for j := 0; j <= i; j++ {
+			storage.append(16, []byte("x"), tag)
+		}
+	}
+
+	names := func(out *TagsOutput) []string {
+		names := []string{}
+		for _, tag := range out.Tags {
+			names = append(names, tag.Name)
+		}
+		return names
+	}
+	expect := func(out *TagsOutput, err error, total uint64, expected ...string) {
+		if err != nil {
+			t.Log(err)
+			t.FailNow()
+		}
+		got := names(out)
+		if out.Total != total || len(got) != len(expected) {
+			t.Logf("expected %v (total %d), got %v (total %d)", expected, total, got, out.Total)
+			t.FailNow()
+		}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Logf("expected %v, got %v", expected, got)
+				t.FailNow()
+			}
+		}
+	}
+
+	out, err := storage.listTags("", "", 0, 100)
+	expect(out, err, 4, "country_nl", "user_1", "user_2", "user_3")
+
+	out, err = storage.listTags("user", tagsSortBySize, 0, 2)
+	expect(out, err, 3, "user_3", "user_2")
+	if out.Tags[0].Count != 3 || out.NextFrom != 2 {
+		t.Logf("unexpected page %v", out)
+		t.FailNow()
+	}
+
+	out, err = storage.listTags("user", tagsSortBySize, int(out.NextFrom), 2)
+	expect(out, err, 3, "user_1")
+
+	out, err = storage.listTags("user", tagsSortBySize, 10, 2)
+	expect(out, err, 3)
+
+	_, err = storage.listTags("", "random", 0, 10)
+	if err == nil {
+		t.Log("expected error for unknown sort")
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestFailedTaggedAppend(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_failed_append_test")
+	os.RemoveAll(root)
+
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+	storage.append(16, []byte("a"), "x")
+
+	// the write of the second append panics, the third one must not
+	// wait for its postings forever
+	descriptor := storage.descriptor
+	readOnly, err := os.Open(path.Join(root, "append.raw"))
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	storage.descriptor = readOnly
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Log("expected the write to panic")
+				t.FailNow()
+			}
+		}()
+		storage.append(16, []byte("b"), "x")
+	}()
+	storage.descriptor = descriptor
+	readOnly.Close()
+
+	done := make(chan uint64)
+	go func() {
+		offset, _ := storage.append(16, []byte("c"), "x")
+		done <- offset
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Log("the append after the failed one is blocked")
+		t.FailNow()
+	}
+	os.RemoveAll(root)
+}
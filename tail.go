@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// if a record can not be read for that long, tail checks if it is still
+// being appended
+const tailStuckTimeout = time.Second
+
+var compactedWhileTailingError = errors.New("namespace was compacted while tailing, offsets have changed")
+
+// tailers wait on the returned channel, it is closed when the next
+// record is appended
+func (this *StoreItem) appendedChannel() chan bool {
+	this.tailLock.Lock()
+	defer this.tailLock.Unlock()
+	if this.appended == nil {
+		this.appended = make(chan bool)
+	}
+	return this.appended
+}
+
+func (this *StoreItem) notifyTailers() {
+	this.tailLock.Lock()
+	defer this.tailLock.Unlock()
+	if this.appended != nil {
+		close(this.appended)
+		this.appended = nil
+	}
+}
+
+// stops the syncer and the tailers when the namespace is closed
+func (this *StoreItem) shutdown() {
+	this.stopSyncing()
+
+	this.tailLock.Lock()
+	defer this.tailLock.Unlock()
+	select {
+	case <-this.closing:
+	default:
+		close(this.closing)
+	}
+}
+
+// streams the records starting at offset, and then blocks and streams
+// the new records as they are appended, until done is closed
+func (this *StoreItem) tail(offset uint64, skipCorrupt bool, done <-chan struct{}, cb func(uint64, *Header, []byte) bool, flush func()) error {
+	generation := atomic.LoadUint64(&this.generation)
+	stuck := time.Time{}
+	for {
+		// take the channel before reading, so we can not miss an append
+		// that happens after we reached the end
+		appended := this.appendedChannel()
+		if atomic.LoadUint64(&this.generation) != generation {
+			return compactedWhileTailingError
+		}
+
+	READ:
+		for offset < atomic.LoadUint64(&this.offset) {
+			output, header, err := this.readRecord(offset)
+			switch err {
+			case nil:
+				if !cb(offset, header, output) {
+					return nil
+				}
+			case deletedError:
+				// deleted records are hidden
+			case wrongValueChecksumError:
+				if !skipCorrupt {
+					return fmt.Errorf("%s at offset %d", err.Error(), offset)
+				}
+			default:
+				// most likely the record is still being appended
+				if stuck.IsZero() {
+					stuck = time.Now()
+				}
+				if time.Since(stuck) < tailStuckTimeout {
+					break READ
+				}
+				// waits for the appends in progress, a record before
+				// the completed offset that still can not be read was
+				// never written (or its header is corrupted)
+				stuck = time.Time{}
+				if offset >= this.completedOffset() {
+					break READ
+				}
+				if atomic.LoadUint64(&this.generation) != generation {
+					return compactedWhileTailingError
+				}
+				_, _, err = this.readRecord(offset)
+				if err == nil || err == deletedError || err == wrongValueChecksumError {
+					continue
+				}
+				if !skipCorrupt {
+					return fmt.Errorf("%s at offset %d", err.Error(), offset)
+				}
+				offset = this.findNextRecord(offset+1, atomic.LoadUint64(&this.offset))
+				continue
+			}
+
+			stuck = time.Time{}
+			offset += uint64(header.allocSize) + header.size()
+		}
+		flush()
+
+		select {
+		case <-appended:
+		case <-done:
+			return nil
+		case <-this.closing:
+			return nil
+		case <-time.After(tailStuckTimeout):
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTail(t *testing.T) {
+	path := path.Join(os.TempDir(), "rochefort_tail_test")
+	os.RemoveAll(path)
+
+	storage := NewStorage(path, Durability{})
+	defer storage.close()
+	for i := 0; i < 10; i++ {
+		storage.append(64, []byte(fmt.Sprintf("%d", i)))
+	}
+
+	received := make(chan string, 100)
+	done := make(chan struct{})
+	finished := make(chan error, 1)
+	go func() {
+		finished <- storage.tail(0, false, done, func(offset uint64, header *Header, data []byte) bool {
+			received <- string(data)
+			return true
+		}, func() {})
+	}()
+
+	go func() {
+		for i := 10; i < 20; i++ {
+			storage.append(64, []byte(fmt.Sprintf("%d", i)))
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		select {
+		case data := <-received:
+			if data != fmt.Sprintf("%d", i) {
+				t.Logf("expected %d, got %s", i, data)
+				t.FailNow()
+			}
+		case <-time.After(5 * time.Second):
+			t.Logf("timed out waiting for record %d", i)
+			t.FailNow()
+		}
+	}
+
+	close(done)
+	err := <-finished
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+
+	// flushed when it is waiting for appends, so the compaction happens
+	// after it started
+	waiting := make(chan bool, 1)
+	go func() {
+		finished <- storage.tail(storage.offset, false, make(chan struct{}), func(offset uint64, header *Header, data []byte) bool {
+			return true
+		}, func() {
+			select {
+			case waiting <- true:
+			default:
+			}
+		})
+	}()
+	<-waiting
+	storage.compact()
+
+	select {
+	case err := <-finished:
+		if err != compactedWhileTailingError {
+			t.Logf("expected compactedWhileTailingError, got %v", err)
+			t.FailNow()
+		}
+	case <-time.After(5 * time.Second):
+		t.Log("tail did not stop after compaction")
+		t.FailNow()
+	}
+	os.RemoveAll(path)
+}
+
+func TestTailStuckAppend(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_tail_stuck_test")
+	os.RemoveAll(root)
+	storage := NewStorage(root, Durability{})
+	defer storage.close()
+
+	tail := func(skipCorrupt bool) (chan string, chan error, chan struct{}) {
+		received := make(chan string, 100)
+		finished := make(chan error, 1)
+		done := make(chan struct{})
+		go func() {
+			finished <- storage.tail(0, skipCorrupt, done, func(offset uint64, header *Header, data []byte) bool {
+				received <- string(data)
+				return true
+			}, func() {})
+		}()
+		return received, finished, done
+	}
+	expect := func(received chan string, expected string) {
+		select {
+		case data := <-received:
+			if data != expected {
+				t.Logf("expected %s, got %s", expected, data)
+				t.FailNow()
+			}
+		case <-time.After(5 * time.Second):
+			t.Logf("timed out waiting for %s", expected)
+			t.FailNow()
+		}
+	}
+
+	received, finished, done := tail(true)
+	storage.append(8, []byte("b"))
+	expect(received, "b")
+
+	// an append that takes longer than tailStuckTimeout is not skipped
+	slow := make(chan bool)
+	go func() {
+		storage.RLock()
+		defer storage.RUnlock()
+		offset := atomic.AddUint64(&storage.offset, 8+headerLen) - 8 - headerLen
+		close(slow)
+		time.Sleep(2 * tailStuckTimeout)
+		storage.descriptor.WriteAt([]byte("c"), int64(offset+headerLen))
+		writeHeader(storage.descriptor, offset, newHeader([]byte("c"), 8))
+	}()
+	<-slow
+	storage.append(8, []byte("d"))
+	expect(received, "c")
+	expect(received, "d")
+	close(done)
+	<-finished
+
+	// a reservation that was never written is reported, or skipped with
+	// skipCorrupt
+	gap := atomic.AddUint64(&storage.offset, 8+headerLen) - 8 - headerLen
+	storage.append(8, []byte("e"))
+
+	received, finished, done = tail(false)
+	expect(received, "b")
+	expect(received, "c")
+	expect(received, "d")
+	select {
+	case err := <-finished:
+		if err == nil || !strings.HasSuffix(err.Error(), fmt.Sprintf("at offset %d", gap)) {
+			t.Logf("expected an error at offset %d, got %v", gap, err)
+			t.FailNow()
+		}
+	case <-time.After(5 * time.Second):
+		t.Log("tail did not report the gap")
+		t.FailNow()
+	}
+	close(done)
+
+	received, finished, done = tail(true)
+	for _, expected := range []string{"b", "c", "d", "e"} {
+		expect(received, expected)
+	}
+	close(done)
+	<-finished
+	os.RemoveAll(root)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"sort"
+	"sync"
+)
+
+// a sparse in memory index of the write time of the records, one record
+// every timeIndexInterval bytes of append.raw, so findOffsetByTime can
+// jump close to the first record at a time and walk the headers from
+// there; it is built by recovery when the namespace is opened, kept up
+// to date by append and rebuilt by compaction
+
+const timeIndexInterval = 256 * 1024
+
+type timeIndex struct {
+	times   []int64
+	offsets []uint64
+	sync.Mutex
+}
+
+// records are added in offset order, concurrent appends finishing out of
+// order are skipped
+func (this *timeIndex) add(t int64, offset uint64) {
+	this.Lock()
+	defer this.Unlock()
+
+	n := len(this.offsets)
+	if n > 0 && offset < this.offsets[n-1]+timeIndexInterval {
+		return
+	}
+	this.times = append(this.times, t)
+	this.offsets = append(this.offsets, offset)
+}
+
+// returns the offset of a record written before t, from where the first
+// record at t can be found by walking the headers
+func (this *timeIndex) before(t int64) uint64 {
+	this.Lock()
+	defer this.Unlock()
+
+	i := sort.Search(len(this.times), func(i int) bool {
+		return this.times[i] >= t
+	})
+	if i == 0 {
+		return 0
+	}
+	return this.offsets[i-1]
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path"
+	"testing"
+)
+
+func TestFindOffsetByTime(t *testing.T) {
+	root := path.Join(os.TempDir(), "rochefort_time_index_test")
+	os.RemoveAll(root)
+
+	storage := NewStorage(root, Durability{})
+	for i := 0; i < 64; i++ {
+		storage.append(1024*1024, []byte("abc"))
+	}
+
+	expect := func(storage *StoreItem, entries int) {
+		offsets := []uint64{}
+		times := []int64{}
+		storage.scan(ScanOptions{}, func(offset uint64, header *Header, data []byte) bool {
+			offsets = append(offsets, offset)
+			times = append(times, header.time)
+			return true
+		})
+		if len(storage.times.offsets) != entries {
+			t.Logf("expected %d entries in the time index, got %d", entries, len(storage.times.offsets))
+			t.FailNow()
+		}
+
+		for i := range times {
+			first := i
+			for first > 0 && times[first-1] >= times[i] {
+				first--
+			}
+			if found := storage.findOffsetByTime(times[i]); found != offsets[first] {
+				t.Logf("expected %d for time %d, got %d", offsets[first], times[i], found)
+				t.FailNow()
+			}
+		}
+		if storage.findOffsetByTime(times[len(times)-1]+1) != storage.offset {
+			t.Log("expected the end of the file for time after the last record")
+			t.FailNow()
+		}
+	}
+	expect(storage, 64)
+
+	// rebuilt by recovery
+	storage.close()
+	storage = NewStorage(root, Durability{})
+	defer storage.close()
+	expect(storage, 64)
+
+	// and by compaction
+	_, err := storage.compact()
+	if err != nil {
+		t.Log(err)
+		t.FailNow()
+	}
+	// the records are small after the compaction
+	expect(storage, 1)
+	os.RemoveAll(root)
+}